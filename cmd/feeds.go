@@ -0,0 +1,270 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/canpok1/ai-feed/internal/app"
+	"github.com/canpok1/ai-feed/internal/domain"
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"github.com/canpok1/ai-feed/internal/infra"
+	"github.com/canpok1/ai-feed/internal/infra/cache"
+	"github.com/canpok1/ai-feed/internal/infra/fetch"
+	"github.com/spf13/cobra"
+)
+
+// feedsExportFormatOPML はOPML形式でのエクスポートを表す
+const feedsExportFormatOPML = "opml"
+
+func makeFeedsCmd(newFetchClient fetchClientFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "feeds",
+		Short: "フィードの一覧を管理します",
+		Long:  `設定ファイルやプロファイル、フィード定義ファイルに登録されたフィードに関する操作を実行します。`,
+	}
+	cmd.SilenceUsage = true
+
+	cmd.AddCommand(makeFeedsExportCmd())
+	cmd.AddCommand(makeFeedsStatusCmd())
+	cmd.AddCommand(makeFeedsCheckCmd(newFetchClient))
+	cmd.AddCommand(makeFeedsDiscoverCmd(fetch.NewFeedDiscoverer))
+
+	return cmd
+}
+
+// makeFeedsExportCmd は登録されたフィードを他のフィードリーダー向けに書き出すコマンドを作成する
+func makeFeedsExportCmd() *cobra.Command {
+	var format string
+	var outputPath string
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "登録されたフィードをOPML形式で書き出します",
+		Long: `設定ファイル・プロファイルの feeds と、--source/--url で指定したフィードを
+OPML形式で書き出します。タグを持つフィードは最初のタグ名のフォルダにまとめられます。`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			exporter, err := createFeedExporter(format)
+			if err != nil {
+				return err
+			}
+
+			_, currentProfile, err := loadCurrentProfile(cmd)
+			if err != nil {
+				return err
+			}
+
+			feeds, err := collectFeeds(cmd, currentProfile)
+			if err != nil {
+				return err
+			}
+
+			var out io.Writer = cmd.OutOrStdout()
+			if outputPath != "" {
+				file, err := os.Create(outputPath)
+				if err != nil {
+					return fmt.Errorf("failed to create output file: %w", err)
+				}
+				defer func() {
+					if err := file.Close(); err != nil {
+						slog.Error("Failed to close output file", "path", outputPath, "error", err)
+					}
+				}()
+				out = file
+			}
+
+			runner := app.NewFeedsExportRunner(exporter, cmd.ErrOrStderr())
+			if err := runner.Run(out, feeds); err != nil {
+				if errors.Is(err, app.ErrNoFeedsToExport) {
+					return fmt.Errorf("エクスポートするフィードがありません。--url、--source またはプロファイルの feeds でフィードを指定してください")
+				}
+				return err
+			}
+
+			if outputPath != "" {
+				fmt.Fprintf(cmd.OutOrStdout(), "フィードを書き出しました: %s\n", outputPath)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&format, "format", feedsExportFormatOPML, "出力形式（opml）")
+	cmd.Flags().StringVarP(&outputPath, "output", "o", "", "出力先のファイルパス（省略時は標準出力）")
+	cmd.Flags().StringSliceP("url", "u", []string{}, "追加で書き出すフィードのURL（複数指定可）")
+	cmd.Flags().StringP("source", "s", "", "追加で書き出すフィード定義ファイルのパス")
+	cmd.Flags().StringP("profile", "p", "", "プロファイルYAMLファイルのパス")
+
+	cmd.SilenceUsage = true
+	return cmd
+}
+
+// makeFeedsStatusCmd はフィードごとの健全性を表示するコマンドを作成する
+func makeFeedsStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "フィードごとの取得状況を表示します",
+		Long: `recommend 実行時に記録したフィードごとの取得状況（連続失敗回数、最終成功日時、
+取得記事数、最後のエラー）を表形式で表示します。
+連続して取得に失敗し、一時的に除外されているフィードは quarantined と表示されます。`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, currentProfile, err := loadCurrentProfile(cmd)
+			if err != nil {
+				return err
+			}
+
+			feeds, err := collectFeeds(cmd, currentProfile)
+			if err != nil {
+				return err
+			}
+
+			if !isFeedStateRecorded(config.Cache) {
+				fmt.Fprintln(cmd.ErrOrStderr(), "警告: キャッシュが無効なため、フィードの取得状況は記録されていません。cache.enabled を true にしてください。")
+			}
+
+			feedStateStore := createFeedStateStore(config.Cache)
+			if err := feedStateStore.Initialize(); err != nil {
+				return fmt.Errorf("failed to initialize feed state store: %w", err)
+			}
+			defer func() {
+				if err := feedStateStore.Close(); err != nil {
+					slog.Error("Failed to close feed state store", "error", err)
+				}
+			}()
+
+			healthMonitor := domain.NewFeedHealthMonitor(feedStateStore, currentProfile.FeedHealth)
+			runner := app.NewFeedsStatusRunner(healthMonitor)
+			if err := runner.Run(cmd.OutOrStdout(), feeds); err != nil {
+				if errors.Is(err, app.ErrNoFeedsToReport) {
+					return fmt.Errorf("表示するフィードがありません。--url、--source またはプロファイルの feeds でフィードを指定してください")
+				}
+				return err
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSliceP("url", "u", []string{}, "追加で表示するフィードのURL（複数指定可）")
+	cmd.Flags().StringP("source", "s", "", "追加で表示するフィード定義ファイルのパス")
+	cmd.Flags().StringP("profile", "p", "", "プロファイルYAMLファイルのパス")
+
+	cmd.SilenceUsage = true
+	return cmd
+}
+
+// makeFeedsCheckCmd は登録されたフィードを実際に取得して検査するコマンドを作成する
+func makeFeedsCheckCmd(newFetchClient fetchClientFactory) *cobra.Command {
+	var format string
+
+	cmd := &cobra.Command{
+		Use:   "check",
+		Short: "登録されたフィードを取得して検査します",
+		Long: `設定ファイル・プロファイルの feeds と、--source/--url で指定したフィードをすべて取得し、
+解析できるか、記事数、最新記事の公開日時、本文を持つ記事数を表示します。
+取得または解析に失敗したフィードがある場合は終了コード1で終了します。`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format = strings.ToLower(format)
+			if format != app.FeedsCheckFormatTable && format != app.FeedsCheckFormatJSON {
+				return fmt.Errorf("未対応の出力形式です: %s（table, jsonのいずれかを指定してください）", format)
+			}
+
+			_, currentProfile, err := loadCurrentProfile(cmd)
+			if err != nil {
+				return err
+			}
+
+			feeds, err := collectFeeds(cmd, currentProfile)
+			if err != nil {
+				return err
+			}
+
+			// 検査では常にフィード全体を取得するため、条件付きリクエストに使う取得状態は保存しない
+			fetchClient, err := newFetchClient(currentProfile.Fetch, cache.NewNopFeedStateStore())
+			if err != nil {
+				fmt.Fprintln(cmd.ErrOrStderr(), "エラー: フィード取得の設定に誤りがあります。fetch.proxy_url や fetch.ca_bundle_path を確認してください。")
+				return fmt.Errorf("failed to create fetch client: %w", err)
+			}
+
+			runner := app.NewFeedsCheckRunner(domain.NewFeedChecker(fetchClient, domain.NewFetcherOptions(currentProfile.Fetch)), cmd.ErrOrStderr())
+			if err := runner.Run(cmd.Context(), cmd.OutOrStdout(), feeds, format); err != nil {
+				switch {
+				case errors.Is(err, app.ErrNoFeedsToCheck):
+					return fmt.Errorf("検査するフィードがありません。--url、--source またはプロファイルの feeds でフィードを指定してください")
+				case errors.Is(err, app.ErrBrokenFeeds):
+					return fmt.Errorf("取得または解析に失敗したフィードがあります（%w）", err)
+				}
+				return err
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&format, "format", app.FeedsCheckFormatTable, "出力形式（table, json）")
+	cmd.Flags().StringSliceP("url", "u", []string{}, "追加で検査するフィードのURL（複数指定可）")
+	cmd.Flags().StringP("source", "s", "", "追加で検査するフィード定義ファイルのパス")
+	cmd.Flags().StringP("profile", "p", "", "プロファイルYAMLファイルのパス")
+
+	cmd.SilenceUsage = true
+	return cmd
+}
+
+// feedDiscovererFactory はフィード取得設定からFeedDiscovererを作成するファクトリ関数型
+type feedDiscovererFactory func(fetchConfig *entity.FetchConfig) (domain.FeedDiscoverer, error)
+
+// makeFeedsDiscoverCmd はWebページのURLからフィードを探すコマンドを作成する
+func makeFeedsDiscoverCmd(newFeedDiscoverer feedDiscovererFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "discover <url>",
+		Short: "WebページのURLからフィードを探します",
+		Long: `ブログのトップページなどのURLを取得し、<link rel="alternate"> で示された
+RSS/Atom/JSON Feedを表示します。リンクが見つからない場合は /feed や /rss.xml などの
+よく使われるパスを試します。`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pageURL := args[0]
+			if err := entity.ValidateURL(pageURL, "URL"); err != nil {
+				return err
+			}
+
+			_, currentProfile, err := loadCurrentProfile(cmd)
+			if err != nil {
+				return err
+			}
+
+			discoverer, err := newFeedDiscoverer(currentProfile.Fetch)
+			if err != nil {
+				fmt.Fprintln(cmd.ErrOrStderr(), "エラー: フィード取得の設定に誤りがあります。fetch.proxy_url や fetch.ca_bundle_path を確認してください。")
+				return fmt.Errorf("failed to create feed discoverer: %w", err)
+			}
+
+			runner := app.NewFeedsDiscoverRunner(discoverer, cmd.ErrOrStderr())
+			if err := runner.Run(cmd.Context(), cmd.OutOrStdout(), pageURL); err != nil {
+				if errors.Is(err, domain.ErrFeedNotDiscovered) {
+					return fmt.Errorf("フィードが見つかりませんでした: %s（ページにRSS/Atom/JSON Feedへのリンクがあるか確認してください）", pageURL)
+				}
+				return err
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP("profile", "p", "", "プロファイルYAMLファイルのパス")
+
+	cmd.SilenceUsage = true
+	return cmd
+}
+
+// createFeedExporter は出力形式に対応するFeedExporterを作成する
+func createFeedExporter(format string) (domain.FeedExporter, error) {
+	switch strings.ToLower(format) {
+	case feedsExportFormatOPML:
+		return infra.NewOPMLFeedExporter("ai-feed"), nil
+	default:
+		return nil, fmt.Errorf("未対応の出力形式です: %s（opmlを指定してください）", format)
+	}
+}
@@ -0,0 +1,250 @@
+package cmd
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/canpok1/ai-feed/internal/infra/fetch"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMakeFeedsCmd(t *testing.T) {
+	cmd := makeFeedsCmd(fetch.NewFetchClient)
+	assert.NotNil(t, cmd)
+	assert.Equal(t, "feeds", cmd.Use)
+	assert.True(t, cmd.HasSubCommands())
+}
+
+func TestFeedsExportCmd(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yml")
+	configYAML := `default_profile:
+  feeds:
+    - url: https://example.com/a.xml
+      name: Feed A
+      tags: [tech]
+    - url: https://example.com/disabled.xml
+      enabled: false
+`
+	require.NoError(t, os.WriteFile(configPath, []byte(configYAML), 0644))
+	restore := setupCfgFileOverride(t, configPath)
+	defer restore()
+
+	t.Run("標準出力にOPMLを書き出す", func(t *testing.T) {
+		cmd := makeFeedsExportCmd()
+		stdout := &bytes.Buffer{}
+		cmd.SetOut(stdout)
+		cmd.SetErr(&bytes.Buffer{})
+		cmd.SetArgs([]string{"--url", "https://example.com/b.xml"})
+
+		require.NoError(t, cmd.Execute())
+
+		output := stdout.String()
+		assert.Contains(t, output, `<outline text="tech" title="tech">`)
+		assert.Contains(t, output, `xmlUrl="https://example.com/a.xml"`)
+		assert.Contains(t, output, `xmlUrl="https://example.com/b.xml"`)
+		assert.NotContains(t, output, "disabled.xml")
+	})
+
+	t.Run("ファイルに書き出す", func(t *testing.T) {
+		outputPath := filepath.Join(tmpDir, "feeds.opml")
+		cmd := makeFeedsExportCmd()
+		stdout := &bytes.Buffer{}
+		cmd.SetOut(stdout)
+		cmd.SetErr(&bytes.Buffer{})
+		cmd.SetArgs([]string{"--format", "opml", "-o", outputPath})
+
+		require.NoError(t, cmd.Execute())
+
+		data, err := os.ReadFile(outputPath)
+		require.NoError(t, err)
+		assert.Contains(t, string(data), `xmlUrl="https://example.com/a.xml"`)
+		assert.Contains(t, stdout.String(), "フィードを書き出しました")
+	})
+
+	t.Run("未対応の形式", func(t *testing.T) {
+		cmd := makeFeedsExportCmd()
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetErr(&bytes.Buffer{})
+		cmd.SetArgs([]string{"--format", "csv"})
+
+		err := cmd.Execute()
+
+		assert.ErrorContains(t, err, "未対応の出力形式です: csv")
+	})
+}
+
+func TestFeedsStatusCmd(t *testing.T) {
+	tmpDir := t.TempDir()
+	feedStatePath := filepath.Join(tmpDir, "feed_state.json")
+	configPath := filepath.Join(tmpDir, "config.yml")
+	configYAML := `default_profile:
+  feeds:
+    - url: https://example.com/a.xml
+    - url: https://example.com/b.xml
+cache:
+  enabled: true
+  file_path: ` + filepath.Join(tmpDir, "history.jsonl") + `
+  feed_state_file_path: ` + feedStatePath + `
+`
+	require.NoError(t, os.WriteFile(configPath, []byte(configYAML), 0644))
+	feedStateJSON := `[
+  {"url": "https://example.com/a.xml", "last_fetched_at": "2024-01-01T00:00:00Z", "last_success_at": "2024-01-01T00:00:00Z", "last_item_count": 10},
+  {"url": "https://example.com/b.xml", "last_fetched_at": "0001-01-01T00:00:00Z", "consecutive_failures": 2, "last_error": "404 Not Found"}
+]`
+	require.NoError(t, os.WriteFile(feedStatePath, []byte(feedStateJSON), 0644))
+	restore := setupCfgFileOverride(t, configPath)
+	defer restore()
+
+	cmd := makeFeedsStatusCmd()
+	stdout := &bytes.Buffer{}
+	cmd.SetOut(stdout)
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{})
+
+	require.NoError(t, cmd.Execute())
+
+	output := stdout.String()
+	assert.Regexp(t, `ok\s+0\s+\S+ \S+\s+10\s+-\s+-\s+https://example.com/a.xml`, output)
+	assert.Regexp(t, `failing\s+2\s+-\s+-\s+-\s+404 Not Found\s+https://example.com/b.xml`, output)
+}
+
+func TestFeedsCheckCmd(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rss.xml":
+			w.Header().Set("Content-Type", "application/rss+xml")
+			_, _ = w.Write([]byte(`<?xml version="1.0"?>
+<rss version="2.0"><channel><title>Test</title>
+<item><title>Article 1</title><link>https://example.com/1</link><description>body</description><pubDate>Mon, 01 Jan 2024 00:00:00 GMT</pubDate></item>
+<item><title>Article 2</title><link>https://example.com/2</link></item>
+</channel></rss>`))
+		case "/broken.xml":
+			_, _ = w.Write([]byte("<html>not a feed</html>"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	configPath := filepath.Join(t.TempDir(), "config.yml")
+	require.NoError(t, os.WriteFile(configPath, []byte("default_profile: {}\n"), 0644))
+	restore := setupCfgFileOverride(t, configPath)
+	defer restore()
+
+	tests := []struct {
+		name        string
+		args        []string
+		expectError string
+		verify      func(t *testing.T, output string)
+	}{
+		{
+			name: "正常なフィードのみ",
+			args: []string{"--url", server.URL + "/rss.xml"},
+			verify: func(t *testing.T, output string) {
+				assert.Regexp(t, `ok\s+2\s+1\s+\S+ \S+\s+-\s+`+server.URL+`/rss.xml`, output)
+			},
+		},
+		{
+			name:        "壊れたフィードを含む",
+			args:        []string{"--format", "json", "--url", server.URL + "/rss.xml", "--url", server.URL + "/broken.xml"},
+			expectError: "取得または解析に失敗したフィードがあります",
+			verify: func(t *testing.T, output string) {
+				assert.Contains(t, output, `"status": "ok"`)
+				assert.Contains(t, output, `"status": "error"`)
+			},
+		},
+		{
+			name:        "未対応の形式",
+			args:        []string{"--format", "csv", "--url", server.URL + "/rss.xml"},
+			expectError: "未対応の出力形式です: csv",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := makeFeedsCheckCmd(fetch.NewFetchClient)
+			stdout := &bytes.Buffer{}
+			cmd.SetOut(stdout)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs(tt.args)
+
+			err := cmd.Execute()
+
+			if tt.expectError != "" {
+				assert.ErrorContains(t, err, tt.expectError)
+			} else {
+				require.NoError(t, err)
+			}
+			if tt.verify != nil {
+				tt.verify(t, stdout.String())
+			}
+		})
+	}
+}
+
+func TestFeedsDiscoverCmd(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/blog/":
+			_, _ = w.Write([]byte(`<html><head><link rel="alternate" type="application/rss+xml" title="Blog" href="/blog/rss.xml"></head></html>`))
+		case "/empty/":
+			_, _ = w.Write([]byte(`<html><head><title>empty</title></head></html>`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	configPath := filepath.Join(t.TempDir(), "config.yml")
+	require.NoError(t, os.WriteFile(configPath, []byte("default_profile: {}\n"), 0644))
+	restore := setupCfgFileOverride(t, configPath)
+	defer restore()
+
+	tests := []struct {
+		name        string
+		args        []string
+		expectError string
+		expectOut   string
+	}{
+		{
+			name:      "フィードが見つかる",
+			args:      []string{server.URL + "/blog/"},
+			expectOut: server.URL + "/blog/rss.xml",
+		},
+		{
+			name:        "フィードが見つからない",
+			args:        []string{server.URL + "/empty/"},
+			expectError: "フィードが見つかりませんでした: " + server.URL + "/empty/",
+		},
+		{
+			name:        "URLの形式が不正",
+			args:        []string{"example.com"},
+			expectError: "URLが正しいURL形式ではありません",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := makeFeedsDiscoverCmd(fetch.NewFeedDiscoverer)
+			stdout := &bytes.Buffer{}
+			cmd.SetOut(stdout)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs(tt.args)
+
+			err := cmd.Execute()
+
+			if tt.expectError != "" {
+				assert.ErrorContains(t, err, tt.expectError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Contains(t, stdout.String(), tt.expectOut)
+		})
+	}
+}
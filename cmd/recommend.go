@@ -4,6 +4,9 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"math/rand/v2"
+	"strings"
+	"time"
 
 	"github.com/canpok1/ai-feed/internal/app"
 	"github.com/canpok1/ai-feed/internal/domain"
@@ -11,6 +14,7 @@ import (
 	"github.com/canpok1/ai-feed/internal/infra"
 	"github.com/canpok1/ai-feed/internal/infra/cache"
 	"github.com/canpok1/ai-feed/internal/infra/comment"
+	"github.com/canpok1/ai-feed/internal/infra/fetch"
 	"github.com/canpok1/ai-feed/internal/infra/message"
 	"github.com/canpok1/ai-feed/internal/infra/profile"
 	"github.com/canpok1/ai-feed/internal/infra/selector"
@@ -19,7 +23,10 @@ import (
 	"github.com/spf13/cobra"
 )
 
-func makeRecommendCmd(fetchClient domain.FetchClient) *cobra.Command {
+// fetchClientFactory はフィード取得設定と取得状態ストアからFetchClientを作成するファクトリ関数型
+type fetchClientFactory func(fetchConfig *entity.FetchConfig, stateStore domain.FeedStateStore) (domain.FetchClient, error)
+
+func makeRecommendCmd(newFetchClient fetchClientFactory) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "recommend",
 		Short: "指定されたURLからランダムな記事を推薦します",
@@ -27,43 +34,9 @@ func makeRecommendCmd(fetchClient domain.FetchClient) *cobra.Command {
 記事を推薦します。`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			slog.Debug("Starting recommend command")
-			configPath := cfgFile
-			if configPath == "" {
-				configPath = "./config.yml"
-			}
-			slog.Debug("Loading config", "config_path", configPath)
-			config, loadErr := infra.NewYamlConfigRepository(configPath).Load()
-			if loadErr != nil {
-				fmt.Fprintf(cmd.ErrOrStderr(), "エラー: 設定ファイルの読み込みに失敗しました: %s\n", configPath)
-				fmt.Fprintln(cmd.ErrOrStderr(), "config.ymlの構文を確認してください。ai-feed init で新しい設定ファイルを生成できます。")
-				slog.Error("Failed to load config", "config_path", configPath, "error", loadErr)
-				return fmt.Errorf("failed to load config: %w", loadErr)
-			}
-
-			profilePath, err := cmd.Flags().GetString("profile")
+			config, currentProfile, err := loadCurrentProfile(cmd)
 			if err != nil {
-				return fmt.Errorf("failed to get profile flag: %w", err)
-			}
-
-			// デフォルトプロファイルを取得
-			var currentProfile *entity.Profile
-			if config.DefaultProfile != nil {
-				currentProfile = config.DefaultProfile
-			} else {
-				currentProfile = &entity.Profile{}
-			}
-
-			// プロファイルファイルが指定されている場合は読み込んでマージ
-			if profilePath != "" {
-				slog.Debug("Loading profile", "profile_path", profilePath)
-				loadedProfile, loadProfileErr := profile.NewYamlProfileRepositoryImpl(profilePath).LoadProfile()
-				if loadProfileErr != nil {
-					fmt.Fprintf(cmd.ErrOrStderr(), "エラー: プロファイルファイルの読み込みに失敗しました: %s\n", profilePath)
-					fmt.Fprintln(cmd.ErrOrStderr(), "プロファイルファイルの形式を確認してください。")
-					slog.Error("Failed to load profile", "profile_path", profilePath, "error", loadProfileErr)
-					return fmt.Errorf("failed to load profile from %s: %w", profilePath, loadProfileErr)
-				}
-				currentProfile.Merge(loadedProfile)
+				return err
 			}
 
 			// プロファイルのバリデーション
@@ -77,13 +50,38 @@ func makeRecommendCmd(fetchClient domain.FetchClient) *cobra.Command {
 				return fmt.Errorf("プロファイルの検証に失敗しました")
 			}
 
+			params, paramsErr := newRecommendParams(cmd, currentProfile)
+			if paramsErr != nil {
+				return fmt.Errorf("failed to create params: %w", paramsErr)
+			}
+
+			// フィードと記事のランダムな選択に使う乱数生成器を作成（シードを指定すると選択を再現できる）
+			seed, err := resolveSeed(cmd, currentProfile)
+			if err != nil {
+				return err
+			}
+			rng := domain.NewRandom(seed)
+
 			// ArticleSelector を作成
-			selectorFactory := selector.NewArticleSelectorFactory()
+			selectorFactory := selector.NewArticleSelectorFactory(rng)
 			articleSelector, err := selectorFactory.MakeArticleSelector(currentProfile.AI, currentProfile.Prompt)
 			if err != nil {
 				return fmt.Errorf("failed to create article selector: %w", err)
 			}
 
+			replay, err := resolveReplayMode(cmd)
+			if err != nil {
+				return err
+			}
+			if replay.enabled && !replay.post {
+				fmt.Fprintln(cmd.ErrOrStderr(), "--replay のため、外部サービスへの投稿と推薦履歴の保存を行いません（投稿する場合は --replay-post を指定してください）。")
+			}
+
+			articleSelector, err = wrapArticleSelector(articleSelector, currentProfile, config.Cache, params.Feeds, replay)
+			if err != nil {
+				return err
+			}
+
 			// Recommender を作成
 			recommender := domain.NewSelectorBasedRecommender(
 				articleSelector,
@@ -95,16 +93,50 @@ func makeRecommendCmd(fetchClient domain.FetchClient) *cobra.Command {
 			// キャッシュ設定の取得
 			cacheEntity := config.Cache
 
+			// フィードの取得状態ストアを作成（条件付きリクエストに使用）
+			// --replayの場合は実行を再現できるように、フィード選択や健全性の記録に保存済みの状態を使わない
+			feedStateStore := createFeedStateStore(cacheEntity)
+			if replay.enabled {
+				feedStateStore = cache.NewNopFeedStateStore()
+			}
+			if err := feedStateStore.Initialize(); err != nil {
+				return fmt.Errorf("failed to initialize feed state store: %w", err)
+			}
+			defer func() {
+				if err := feedStateStore.Close(); err != nil {
+					slog.Error("Failed to close feed state store", "error", err)
+				}
+			}()
+			fetchClient, err := createFetchClient(cmd, newFetchClient, currentProfile.Fetch, feedStateStore)
+			if err != nil {
+				fmt.Fprintln(cmd.ErrOrStderr(), "エラー: フィード取得の設定に誤りがあります。fetch.proxy_url や fetch.ca_bundle_path、--replay のディレクトリを確認してください。")
+				return fmt.Errorf("failed to create fetch client: %w", err)
+			}
+
 			// MessageSenderファクトリ関数（インフラ層の実装をラップ）
 			senderFactory := func(outputConfig *entity.OutputConfig) ([]domain.MessageSender, error) {
+				if !replay.sideEffects() {
+					return nil, nil
+				}
 				return createMessageSenders(outputConfig)
 			}
 
 			// RecommendCacheファクトリ関数（インフラ層の実装をラップ）
 			cacheFactory := func(cacheConfig *entity.CacheConfig) (domain.RecommendCache, error) {
+				if !replay.sideEffects() {
+					return cache.NewNopCache(), nil
+				}
 				return createRecommendCache(cacheConfig)
 			}
 
+			// FeedSelectorファクトリ関数（プロファイルのフィード選択戦略に従う）
+			feedSelectorFactory := func(recommendCache domain.RecommendCache, rng *rand.Rand) (domain.FeedSelector, error) {
+				return createFeedSelector(currentProfile.FeedSelection, cacheEntity, feedStateStore, recommendCache, rng)
+			}
+
+			// フィードの健全性を記録し、連続して失敗しているフィードを一時的に除外する
+			healthMonitor := domain.NewFeedHealthMonitor(feedStateStore, currentProfile.FeedHealth)
+
 			recommendRunner, runnerErr := app.NewRecommendRunner(
 				fetchClient,
 				recommender,
@@ -113,17 +145,17 @@ func makeRecommendCmd(fetchClient domain.FetchClient) *cobra.Command {
 				currentProfile.Output,
 				currentProfile.Prompt,
 				cacheEntity,
+				currentProfile.Fetch,
 				senderFactory,
 				cacheFactory,
+				feedSelectorFactory,
+				healthMonitor,
+				rng,
 			)
 			if runnerErr != nil {
 				return fmt.Errorf("failed to create runner: %w", runnerErr)
 			}
 
-			params, paramsErr := newRecommendParams(cmd)
-			if paramsErr != nil {
-				return fmt.Errorf("failed to create params: %w", paramsErr)
-			}
 			err = recommendRunner.Run(cmd.Context(), params, currentProfile)
 			if err != nil {
 				// 記事が見つからない場合は友好的なメッセージを表示してエラーではない扱いにする
@@ -140,15 +172,105 @@ func makeRecommendCmd(fetchClient domain.FetchClient) *cobra.Command {
 		},
 	}
 
-	cmd.Flags().StringSliceP("url", "u", []string{}, "推薦元となるフィードのURL（複数指定可）")
+	cmd.Flags().StringSliceP("url", "u", []string{}, "推薦元となるフィードのURL、ファイル・ディレクトリのパス、または標準入力を表す -（複数指定可）")
 	cmd.Flags().StringP("source", "s", "", "URLリストを含むファイルのパス")
 	cmd.Flags().StringP("profile", "p", "", "プロファイルYAMLファイルのパス")
+	cmd.Flags().String("record", "", "取得したフィードのレスポンスを保存するディレクトリ")
+	cmd.Flags().String("replay", "", "--record で保存したレスポンスからフィードを読み込むディレクトリ")
+	cmd.Flags().Bool("replay-post", false, "--replay でも設定された投稿先に投稿し、推薦履歴を保存する")
+	cmd.MarkFlagsMutuallyExclusive("record", "replay")
+	cmd.Flags().Uint64("seed", 0, "フィードと記事のランダムな選択に使う乱数のシード（設定ファイルのseedより優先）")
 
 	cmd.SilenceUsage = true
 	return cmd
 }
 
-func newRecommendParams(cmd *cobra.Command) (*app.RecommendParams, error) {
+// loadCurrentProfile は設定ファイルを読み込み、デフォルトプロファイルに--profileで指定されたプロファイルをマージして返す
+func loadCurrentProfile(cmd *cobra.Command) (*domain.LoadedConfig, *entity.Profile, error) {
+	configPath := cfgFile
+	if configPath == "" {
+		configPath = "./config.yml"
+	}
+	slog.Debug("Loading config", "config_path", configPath)
+	config, loadErr := infra.NewYamlConfigRepository(configPath).Load()
+	if loadErr != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "エラー: 設定ファイルの読み込みに失敗しました: %s\n", configPath)
+		fmt.Fprintln(cmd.ErrOrStderr(), "config.ymlの構文を確認してください。ai-feed init で新しい設定ファイルを生成できます。")
+		slog.Error("Failed to load config", "config_path", configPath, "error", loadErr)
+		return nil, nil, fmt.Errorf("failed to load config: %w", loadErr)
+	}
+
+	profilePath, err := cmd.Flags().GetString("profile")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get profile flag: %w", err)
+	}
+
+	// デフォルトプロファイルを取得
+	var currentProfile *entity.Profile
+	if config.DefaultProfile != nil {
+		currentProfile = config.DefaultProfile
+	} else {
+		currentProfile = &entity.Profile{}
+	}
+
+	// プロファイルファイルが指定されている場合は読み込んでマージ
+	if profilePath != "" {
+		slog.Debug("Loading profile", "profile_path", profilePath)
+		loadedProfile, loadProfileErr := profile.NewYamlProfileRepositoryImpl(profilePath).LoadProfile()
+		if loadProfileErr != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "エラー: プロファイルファイルの読み込みに失敗しました: %s\n", profilePath)
+			fmt.Fprintln(cmd.ErrOrStderr(), "プロファイルファイルの形式を確認してください。")
+			slog.Error("Failed to load profile", "profile_path", profilePath, "error", loadProfileErr)
+			return nil, nil, fmt.Errorf("failed to load profile from %s: %w", profilePath, loadProfileErr)
+		}
+		currentProfile.Merge(loadedProfile)
+	}
+
+	return config, currentProfile, nil
+}
+
+// newRecommendParams はプロファイルのfeeds、--source、--urlの順にフィードを集めて実行パラメータを作成する
+func newRecommendParams(cmd *cobra.Command, profile *entity.Profile) (*app.RecommendParams, error) {
+	feeds, err := collectFeeds(cmd, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	// いずれのオプションも指定されていない場合はエラー
+	if len(feeds) == 0 {
+		return nil, fmt.Errorf("--url、--source またはプロファイルの feeds のいずれかでフィードを指定してください")
+	}
+
+	// 無効化されたフィードを除外
+	enabledFeeds := entity.FilterEnabledFeeds(feeds)
+	if len(enabledFeeds) < len(feeds) {
+		slog.Info("Skipping disabled feeds", "disabled_count", len(feeds)-len(enabledFeeds))
+	}
+	if len(enabledFeeds) == 0 {
+		return nil, fmt.Errorf("有効なフィードがありません。enabled: false になっていないか確認してください")
+	}
+	feeds = enabledFeeds
+
+	// 認証情報やヘッダーを持つフィード定義は、設定の不足を取得前に検出するためバリデーションする
+	// URLのみのフィードは従来どおり取得時のエラーとして扱う
+	builder := entity.NewValidationBuilder()
+	for i := range feeds {
+		if feeds[i].Auth == nil && len(feeds[i].Headers) == 0 {
+			continue
+		}
+		builder.MergeResult(feeds[i].Validate())
+	}
+	if result := builder.Build(); !result.IsValid {
+		return nil, fmt.Errorf("フィード定義が不正です: %s", strings.Join(result.Errors, ", "))
+	}
+
+	return &app.RecommendParams{
+		Feeds: feeds,
+	}, nil
+}
+
+// collectFeeds はプロファイルのfeeds、--source、--urlの順にフィードを集め、重複したURLを取り除いて返す
+func collectFeeds(cmd *cobra.Command, profile *entity.Profile) ([]entity.Feed, error) {
 	urlList, err := cmd.Flags().GetStringSlice("url")
 	if err != nil {
 		return nil, fmt.Errorf("failed to get url flag: %w", err)
@@ -158,30 +280,43 @@ func newRecommendParams(cmd *cobra.Command) (*app.RecommendParams, error) {
 		return nil, fmt.Errorf("failed to get source flag: %w", err)
 	}
 
-	var urls []string
+	var feeds []entity.Feed
 
-	// --source オプションが指定されている場合、ファイルからURLを読み込む
+	// プロファイルのfeedsセクションで定義されたフィードを追加
+	if profile != nil {
+		feeds = append(feeds, profile.Feeds...)
+	}
+
+	// --source オプションが指定されている場合、ファイルからフィード定義を読み込む
 	if sourcePath != "" {
-		sourceURLs, err := infra.ReadURLsFromFile(sourcePath)
+		sourceFeeds, err := infra.ReadFeedsFromFile(sourcePath)
 		if err != nil {
-			return nil, fmt.Errorf("failed to read URLs from file: %w", err)
+			return nil, fmt.Errorf("failed to read feeds from file: %w", err)
 		}
-		urls = append(urls, sourceURLs...)
+		feeds = append(feeds, sourceFeeds...)
 	}
 
 	// -u オプションで指定されたURLを追加
 	if len(urlList) > 0 {
-		urls = append(urls, urlList...)
+		feeds = append(feeds, entity.NewFeedsFromURLs(urlList)...)
 	}
 
-	// いずれのオプションも指定されていない場合はエラー
-	if len(urls) == 0 {
-		return nil, fmt.Errorf("--url または --source のいずれかを指定してください")
-	}
+	return uniqueFeeds(feeds), nil
+}
 
-	return &app.RecommendParams{
-		URLs: urls,
-	}, nil
+// uniqueFeeds は同じURLのフィードが複数指定された場合に先に指定された定義のみを残す
+func uniqueFeeds(feeds []entity.Feed) []entity.Feed {
+	seen := make(map[string]bool, len(feeds))
+	result := make([]entity.Feed, 0, len(feeds))
+	for _, feed := range feeds {
+		if seen[feed.URL] {
+			slog.Debug("Skipping duplicate feed", "url", feed.URL)
+			continue
+		}
+		seen[feed.URL] = true
+		result = append(result, feed)
+	}
+	return result
 }
 
 // createMessageSenders はOutputConfigに基づいてMessageSenderのリストを作成する
@@ -233,3 +368,177 @@ func createRecommendCache(cacheConfig *entity.CacheConfig) (domain.RecommendCach
 
 	return cache.NewFileRecommendCache(cacheConfig), nil
 }
+
+// createFeedSelector はプロファイルのフィード選択戦略に基づいてFeedSelectorを作成する
+func createFeedSelector(
+	selectionConfig *entity.FeedSelectionConfig,
+	cacheConfig *entity.CacheConfig,
+	stateStore domain.FeedStateStore,
+	recommendCache domain.RecommendCache,
+	rng *rand.Rand,
+) (domain.FeedSelector, error) {
+	strategy := selectionConfig.StrategyOrDefault()
+
+	// round_robinとleast_recentは実行をまたいだ状態をキャッシュディレクトリに保存するため、キャッシュが無効だと常に先頭のフィードが選ばれる
+	cacheEnabled := cacheConfig != nil && cacheConfig.Enabled != nil && *cacheConfig.Enabled
+	if !cacheEnabled && (strategy == entity.FeedSelectionStrategyRoundRobin || strategy == entity.FeedSelectionStrategyLeastRecent) {
+		slog.Warn("Feed selection strategy requires cache to be enabled; the first feed will always be selected", "strategy", strategy)
+	}
+
+	return domain.NewFeedSelector(strategy, stateStore, recommendCache, rng)
+}
+
+// resolveSeed は--seed、プロファイルのseedの順に乱数のシードを決め、再現できるようにログに出力する
+// どちらも指定されていない場合は実行ごとに異なるシードを使う
+func resolveSeed(cmd *cobra.Command, profile *entity.Profile) (uint64, error) {
+	seed, err := cmd.Flags().GetUint64("seed")
+	if err != nil {
+		return 0, fmt.Errorf("failed to get seed flag: %w", err)
+	}
+
+	source := "flag"
+	switch {
+	case cmd.Flags().Changed("seed"):
+	case profile.Seed != nil:
+		seed = *profile.Seed
+		source = "config"
+	default:
+		seed = domain.NewRandomSeed()
+		source = "random"
+	}
+	slog.Info("Using random seed (pass --seed to reproduce this run)", "seed", seed, "source", source)
+	return seed, nil
+}
+
+// replayMode は--replayと--replay-postの指定を表す
+type replayMode struct {
+	enabled bool // --replayで保存済みのレスポンスからフィードを読み込む
+	post    bool // --replay-postで投稿先に投稿する
+}
+
+// sideEffects は投稿と推薦履歴の保存を行うかどうかを返す
+// --replayの場合は実行を再現できるように、--replay-postを指定した場合のみ行う
+func (m replayMode) sideEffects() bool {
+	return !m.enabled || m.post
+}
+
+// resolveReplayMode は--replayと--replay-postの指定を返す
+func resolveReplayMode(cmd *cobra.Command) (replayMode, error) {
+	replayDir, err := cmd.Flags().GetString("replay")
+	if err != nil {
+		return replayMode{}, fmt.Errorf("failed to get replay flag: %w", err)
+	}
+	post, err := cmd.Flags().GetBool("replay-post")
+	if err != nil {
+		return replayMode{}, fmt.Errorf("failed to get replay-post flag: %w", err)
+	}
+	if post && replayDir == "" {
+		return replayMode{}, fmt.Errorf("--replay-post は --replay と一緒に指定してください")
+	}
+	return replayMode{enabled: replayDir != "", post: post}, nil
+}
+
+// createFetchClient は--record/--replayの指定に応じてFetchClientを作成する
+// --replayの場合はネットワークにアクセスせず、保存済みのレスポンスからフィードを読み込む
+func createFetchClient(cmd *cobra.Command, newFetchClient fetchClientFactory, fetchConfig *entity.FetchConfig, stateStore domain.FeedStateStore) (domain.FetchClient, error) {
+	recordDir, err := cmd.Flags().GetString("record")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get record flag: %w", err)
+	}
+	replayDir, err := cmd.Flags().GetString("replay")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get replay flag: %w", err)
+	}
+
+	switch {
+	case replayDir != "":
+		slog.Info("Replaying recorded feeds", "dir", replayDir)
+		return fetch.NewReplayFetchClient(replayDir, fetchConfig)
+	case recordDir != "":
+		slog.Info("Recording fetched feeds", "dir", recordDir)
+		return fetch.NewRecordingFetchClient(fetchConfig, stateStore, fetch.NewSnapshotStore(recordDir))
+	default:
+		return newFetchClient(fetchConfig, stateStore)
+	}
+}
+
+// wrapArticleSelector は選択した記事のページにアクセスして、投稿するURLや本文を補う処理を追加する
+// --replayの場合は結果がネットワークに左右されないように、記事ページにはアクセスしない
+func wrapArticleSelector(
+	articleSelector domain.ArticleSelector,
+	profile *entity.Profile,
+	cacheConfig *entity.CacheConfig,
+	feeds []entity.Feed,
+	replay replayMode,
+) (domain.ArticleSelector, error) {
+	if replay.enabled {
+		if profile.CanonicalURL.IsResolveEnabled() || isFullTextEnabled(profile.FullText, feeds) {
+			slog.Info("Skipping article page access while replaying", "canonical_url", profile.CanonicalURL.IsResolveEnabled(), "full_text", isFullTextEnabled(profile.FullText, feeds))
+		}
+		return articleSelector, nil
+	}
+
+	// 選択した記事のリダイレクト先や<link rel="canonical">を投稿するURLに使う
+	if profile.CanonicalURL.IsResolveEnabled() {
+		resolver, err := fetch.NewURLResolver(profile.Fetch, profile.CanonicalURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create URL resolver: %w", err)
+		}
+		articleSelector = domain.NewCanonicalURLArticleSelector(articleSelector, resolver, domain.NewURLNormalizer(profile.CanonicalURL))
+	}
+
+	// 本文が短い記事は、選択後に記事ページから本文を取得してコメント生成に使う
+	if isFullTextEnabled(profile.FullText, feeds) {
+		extractor, err := createArticleExtractor(profile.Fetch, cacheConfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create article extractor: %w", err)
+		}
+		articleSelector = domain.NewFullTextArticleSelector(articleSelector, extractor, profile.FullText, feeds)
+	}
+	return articleSelector, nil
+}
+
+// isFullTextEnabled はいずれかのフィードで記事ページからの本文取得が有効かどうかを返す
+func isFullTextEnabled(fullTextConfig *entity.FullTextConfig, feeds []entity.Feed) bool {
+	for _, feed := range feeds {
+		if fullTextConfig.IsEnabledFor(feed) {
+			return true
+		}
+	}
+	return false
+}
+
+// createArticleExtractor は記事ページから本文を抽出するArticleExtractorを作成する
+// キャッシュが有効な場合は、抽出した本文をキャッシュの保持期間だけディスクに保存する
+func createArticleExtractor(fetchConfig *entity.FetchConfig, cacheConfig *entity.CacheConfig) (domain.ArticleExtractor, error) {
+	extractor, err := fetch.NewArticleExtractor(fetchConfig)
+	if err != nil {
+		return nil, err
+	}
+	if cacheConfig == nil || cacheConfig.Enabled == nil || !*cacheConfig.Enabled || cacheConfig.ArticleCacheDir == "" {
+		return extractor, nil
+	}
+
+	ttl := time.Duration(cacheConfig.RetentionDays) * 24 * time.Hour
+	cachedExtractor := cache.NewCachedArticleExtractor(extractor, cacheConfig.ArticleCacheDir, ttl)
+	// 保持期間を過ぎた本文の削除に失敗しても、本文の取得には影響しないため続行する
+	if err := cachedExtractor.Initialize(); err != nil {
+		slog.Warn("Failed to clean up article cache", "dir", cacheConfig.ArticleCacheDir, "error", err)
+	}
+	return cachedExtractor, nil
+}
+
+// isFeedStateRecorded はフィードの取得状態がファイルに記録される設定かどうかを返す
+func isFeedStateRecorded(cacheConfig *entity.CacheConfig) bool {
+	return cacheConfig != nil && cacheConfig.Enabled != nil && *cacheConfig.Enabled && cacheConfig.FeedStateFilePath != ""
+}
+
+// createFeedStateStore はCacheConfigに基づいてFeedStateStoreを作成する
+// キャッシュが無効な場合は状態を保存せず、毎回通常のリクエストで取得する
+func createFeedStateStore(cacheConfig *entity.CacheConfig) domain.FeedStateStore {
+	if !isFeedStateRecorded(cacheConfig) {
+		return cache.NewNopFeedStateStore()
+	}
+
+	return cache.NewFileFeedStateStore(cacheConfig.FeedStateFilePath)
+}
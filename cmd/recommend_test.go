@@ -1,11 +1,19 @@
 package cmd
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"sync/atomic"
 	"testing"
 
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"github.com/canpok1/ai-feed/internal/testutil"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // newTestRecommendCmd はテスト用のcobra.Commandを作成するヘルパー関数
@@ -16,6 +24,15 @@ func newTestRecommendCmd() *cobra.Command {
 	return cmd
 }
 
+// feedURLs はフィード定義の一覧からURLのみを取り出す
+func feedURLs(feeds []entity.Feed) []string {
+	var urls []string
+	for _, feed := range feeds {
+		urls = append(urls, feed.URL)
+	}
+	return urls
+}
+
 func TestNewRecommendParams(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -56,21 +73,21 @@ func TestNewRecommendParams(t *testing.T) {
 			urlFlags:     []string{},
 			sourceFlag:   "",
 			expectedURLs: nil,
-			expectedErr:  "--url または --source のいずれかを指定してください",
+			expectedErr:  "--url、--source またはプロファイルの feeds のいずれかでフィードを指定してください",
 		},
 		{
 			name:         "異常系: ソースファイルが見つからない",
 			urlFlags:     []string{},
 			sourceFlag:   "non_existent_file.txt",
 			expectedURLs: nil,
-			expectedErr:  "failed to read URLs from file: failed to open file non_existent_file.txt: open non_existent_file.txt: no such file or directory",
+			expectedErr:  "failed to read feeds from file: failed to open file non_existent_file.txt: open non_existent_file.txt: no such file or directory",
 		},
 		{
 			name:         "異常系: 空のソースファイル",
 			urlFlags:     []string{},
 			sourceFlag:   "empty_source.txt",
 			expectedURLs: nil,
-			expectedErr:  "--url または --source のいずれかを指定してください",
+			expectedErr:  "--url、--source またはプロファイルの feeds のいずれかでフィードを指定してください",
 		},
 		{
 			name:         "正常系: 空のソースファイルでもURLあり",
@@ -104,7 +121,7 @@ func TestNewRecommendParams(t *testing.T) {
 				cmd.Flags().Set("source", tt.sourceFlag)
 			}
 
-			params, err := newRecommendParams(cmd)
+			params, err := newRecommendParams(cmd, nil)
 
 			if tt.expectedErr != "" {
 				assert.Error(t, err)
@@ -113,7 +130,7 @@ func TestNewRecommendParams(t *testing.T) {
 			} else {
 				assert.NoError(t, err)
 				assert.NotNil(t, params)
-				assert.Equal(t, tt.expectedURLs, params.URLs)
+				assert.Equal(t, tt.expectedURLs, feedURLs(params.Feeds))
 			}
 		})
 	}
@@ -126,10 +143,10 @@ func TestNewRecommendParams(t *testing.T) {
 			cmd.Flags().Set("url", url)
 		}
 
-		params, err := newRecommendParams(cmd)
+		params, err := newRecommendParams(cmd, nil)
 		assert.NoError(t, err)
 		assert.NotNil(t, params)
-		assert.Len(t, params.URLs, 3)
+		assert.Len(t, params.Feeds, 3)
 	})
 
 	// 統合テスト: URLとソースの組み合わせ
@@ -147,10 +164,10 @@ func TestNewRecommendParams(t *testing.T) {
 		cmd.Flags().Set("url", "https://example.com/feed.xml")
 		cmd.Flags().Set("source", sourceFile)
 
-		params, err := newRecommendParams(cmd)
+		params, err := newRecommendParams(cmd, nil)
 		assert.NoError(t, err)
 		assert.NotNil(t, params)
-		assert.Len(t, params.URLs, 3) // URLから1件 + ソースから2件
+		assert.Len(t, params.Feeds, 3) // URLから1件 + ソースから2件
 	})
 
 	// 正常系: 空行や空白を含むソースファイル
@@ -166,12 +183,12 @@ func TestNewRecommendParams(t *testing.T) {
 
 		cmd.Flags().Set("source", sourceFile)
 
-		params, err := newRecommendParams(cmd)
+		params, err := newRecommendParams(cmd, nil)
 		assert.NoError(t, err)
 		assert.NotNil(t, params)
-		assert.Greater(t, len(params.URLs), 0)
-		for _, url := range params.URLs {
-			assert.True(t, len(url) > 0, "URLは空であってはならない")
+		assert.Greater(t, len(params.Feeds), 0)
+		for _, feed := range params.Feeds {
+			assert.True(t, len(feed.URL) > 0, "URLは空であってはならない")
 		}
 	})
 
@@ -181,9 +198,259 @@ func TestNewRecommendParams(t *testing.T) {
 
 		cmd.Flags().Set("url", "https://example.com/feed.xml")
 
-		params, err := newRecommendParams(cmd)
+		params, err := newRecommendParams(cmd, nil)
 		assert.NoError(t, err)
 		assert.NotNil(t, params)
-		assert.Equal(t, []string{"https://example.com/feed.xml"}, params.URLs)
+		assert.Equal(t, []string{"https://example.com/feed.xml"}, feedURLs(params.Feeds))
 	})
 }
+
+func TestNewRecommendParams_YAMLSource(t *testing.T) {
+	t.Setenv("TEST_FEED_TOKEN", "secret-token")
+
+	tests := []struct {
+		name        string
+		content     string
+		expectedErr string
+		assertFeeds func(t *testing.T, feeds []entity.Feed)
+	}{
+		{
+			name: "正常系: 認証情報とヘッダーを持つフィード定義",
+			content: `feeds:
+  - url: https://gitlab.example.com/project.atom
+    auth:
+      type: header
+      header_name: PRIVATE-TOKEN
+      token_env: TEST_FEED_TOKEN
+    headers:
+      Accept-Language: ja
+  - url: https://example.com/feed.xml
+`,
+			assertFeeds: func(t *testing.T, feeds []entity.Feed) {
+				require.Len(t, feeds, 2)
+				require.NotNil(t, feeds[0].Auth)
+				assert.Equal(t, entity.FeedAuthTypeHeader, feeds[0].Auth.Type)
+				assert.Equal(t, "PRIVATE-TOKEN", feeds[0].Auth.HeaderName)
+				assert.Equal(t, "secret-token", feeds[0].Auth.Token.Value())
+				assert.Equal(t, map[string]string{"Accept-Language": "ja"}, feeds[0].Headers)
+				assert.Nil(t, feeds[1].Auth)
+			},
+		},
+		{
+			name: "異常系: 環境変数が未設定",
+			content: `feeds:
+  - url: https://example.com/feed.xml
+    auth:
+      type: bearer
+      token_env: TEST_FEED_TOKEN_NOT_SET
+`,
+			expectedErr: "feeds[0].auth.token_env",
+		},
+		{
+			name: "異常系: Basic認証のパスワードが未設定",
+			content: `feeds:
+  - url: https://example.com/feed.xml
+    auth:
+      type: basic
+      username: user
+`,
+			expectedErr: "Basic認証のパスワードが設定されていません",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sourcePath := filepath.Join(t.TempDir(), "feeds.yml")
+			require.NoError(t, os.WriteFile(sourcePath, []byte(tt.content), 0644))
+
+			cmd := newTestRecommendCmd()
+			require.NoError(t, cmd.Flags().Set("source", sourcePath))
+
+			params, err := newRecommendParams(cmd, nil)
+
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				assert.Nil(t, params)
+				return
+			}
+			require.NoError(t, err)
+			tt.assertFeeds(t, params.Feeds)
+		})
+	}
+}
+
+func TestNewRecommendParams_ProfileFeeds(t *testing.T) {
+	disabled := false
+
+	tests := []struct {
+		name         string
+		profile      *entity.Profile
+		urlFlags     []string
+		expectedURLs []string
+		expectedErr  string
+	}{
+		{
+			name: "正常系: プロファイルのフィードのみ",
+			profile: &entity.Profile{Feeds: []entity.Feed{
+				{URL: "https://example.com/a.xml", Name: "A"},
+				{URL: "https://example.com/b.xml", Name: "B"},
+			}},
+			expectedURLs: []string{"https://example.com/a.xml", "https://example.com/b.xml"},
+		},
+		{
+			name: "正常系: プロファイルと--urlの組み合わせで重複は先の定義を残す",
+			profile: &entity.Profile{Feeds: []entity.Feed{
+				{URL: "https://example.com/a.xml", Name: "A"},
+			}},
+			urlFlags:     []string{"https://example.com/a.xml", "https://example.com/c.xml"},
+			expectedURLs: []string{"https://example.com/a.xml", "https://example.com/c.xml"},
+		},
+		{
+			name: "正常系: 無効なフィードは除外される",
+			profile: &entity.Profile{Feeds: []entity.Feed{
+				{URL: "https://example.com/a.xml", Enabled: &disabled},
+				{URL: "https://example.com/b.xml"},
+			}},
+			expectedURLs: []string{"https://example.com/b.xml"},
+		},
+		{
+			name: "異常系: すべてのフィードが無効",
+			profile: &entity.Profile{Feeds: []entity.Feed{
+				{URL: "https://example.com/a.xml", Enabled: &disabled},
+			}},
+			expectedErr: "有効なフィードがありません",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := newTestRecommendCmd()
+			for _, url := range tt.urlFlags {
+				require.NoError(t, cmd.Flags().Set("url", url))
+			}
+
+			params, err := newRecommendParams(cmd, tt.profile)
+
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				assert.Nil(t, params)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedURLs, feedURLs(params.Feeds))
+			if tt.profile != nil && len(tt.profile.Feeds) > 0 && tt.profile.Feeds[0].Name != "" {
+				assert.Equal(t, tt.profile.Feeds[0].Name, params.Feeds[0].Name)
+			}
+		})
+	}
+}
+
+func TestResolveSeed(t *testing.T) {
+	configSeed := uint64(7)
+
+	tests := []struct {
+		name     string
+		args     []string
+		profile  *entity.Profile
+		expected uint64
+	}{
+		{name: "--seedを優先する", args: []string{"--seed", "42"}, profile: &entity.Profile{Seed: &configSeed}, expected: 42},
+		{name: "--seed 0も指定として扱う", args: []string{"--seed", "0"}, profile: &entity.Profile{Seed: &configSeed}, expected: 0},
+		{name: "プロファイルのseedを使う", profile: &entity.Profile{Seed: &configSeed}, expected: 7},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &cobra.Command{}
+			cmd.Flags().Uint64("seed", 0, "")
+			require.NoError(t, cmd.ParseFlags(tt.args))
+
+			seed, err := resolveSeed(cmd, tt.profile)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, seed)
+		})
+	}
+}
+
+func TestResolveReplayMode(t *testing.T) {
+	tests := []struct {
+		name            string
+		args            []string
+		wantEnabled     bool
+		wantSideEffects bool
+		wantErr         bool
+	}{
+		{name: "--replayなし", wantEnabled: false, wantSideEffects: true},
+		{name: "--replayのみでは投稿しない", args: []string{"--replay", "snapshots"}, wantEnabled: true, wantSideEffects: false},
+		{name: "--replay-postで投稿する", args: []string{"--replay", "snapshots", "--replay-post"}, wantEnabled: true, wantSideEffects: true},
+		{name: "--replay-postのみはエラー", args: []string{"--replay-post"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &cobra.Command{}
+			cmd.Flags().String("replay", "", "")
+			cmd.Flags().Bool("replay-post", false, "")
+			require.NoError(t, cmd.ParseFlags(tt.args))
+
+			mode, err := resolveReplayMode(cmd)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantEnabled, mode.enabled)
+			assert.Equal(t, tt.wantSideEffects, mode.sideEffects())
+		})
+	}
+}
+
+// firstArticleSelector は先頭の記事を選択するテスト用のArticleSelector
+type firstArticleSelector struct{}
+
+func (firstArticleSelector) Select(_ context.Context, articles []entity.Article) (*entity.Article, error) {
+	return &articles[0], nil
+}
+
+func TestWrapArticleSelector(t *testing.T) {
+	tests := []struct {
+		name         string
+		replay       replayMode
+		wantAccessed bool
+	}{
+		{name: "--replayの場合は記事ページにアクセスしない", replay: replayMode{enabled: true}, wantAccessed: false},
+		{name: "--replay-postでも記事ページにアクセスしない", replay: replayMode{enabled: true, post: true}, wantAccessed: false},
+		{name: "通常は記事ページにアクセスする", replay: replayMode{}, wantAccessed: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var accessed atomic.Bool
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				accessed.Store(true)
+				if tt.replay.enabled {
+					t.Errorf("unexpected request while replaying: %s", r.URL)
+				}
+				_, _ = w.Write([]byte(`<html><head><link rel="canonical" href="https://example.com/post"></head><body><article><p>full text</p></article></body></html>`))
+			}))
+			defer server.Close()
+
+			profile := &entity.Profile{
+				FullText:     &entity.FullTextConfig{Enabled: testutil.BoolPtr(true), MinContentLength: 1000},
+				CanonicalURL: &entity.CanonicalURLConfig{FollowRedirects: testutil.BoolPtr(true), UseCanonicalLink: testutil.BoolPtr(true)},
+			}
+			feeds := []entity.Feed{{URL: server.URL + "/feed.xml"}}
+			articles := []entity.Article{{Title: "記事", Link: server.URL + "/post", Content: "短い本文", FeedURL: feeds[0].URL}}
+
+			selector, err := wrapArticleSelector(firstArticleSelector{}, profile, nil, feeds, tt.replay)
+			require.NoError(t, err)
+			selected, err := selector.Select(context.Background(), articles)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantAccessed, accessed.Load())
+			if !tt.wantAccessed {
+				assert.Equal(t, articles[0], *selected)
+			}
+		})
+	}
+}
@@ -1,6 +1,11 @@
 package cmd
 
 import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
 	"github.com/canpok1/ai-feed/internal/infra"
 	"github.com/canpok1/ai-feed/internal/infra/fetch"
 	"github.com/spf13/cobra"
@@ -30,7 +35,7 @@ func Execute() error {
 		infra.InitLogger(verbose)
 	}
 
-	recommendCmd := makeRecommendCmd(fetch.NewFetchClient())
+	recommendCmd := makeRecommendCmd(fetch.NewFetchClient)
 	rootCmd.AddCommand(recommendCmd)
 
 	rootCmd.AddCommand(makeInitCmd())
@@ -39,7 +44,13 @@ func Execute() error {
 
 	rootCmd.AddCommand(makeConfigCmd())
 
+	rootCmd.AddCommand(makeFeedsCmd(fetch.NewFetchClient))
+
 	rootCmd.AddCommand(makeVersionCmd())
 
-	return rootCmd.Execute()
+	// Ctrl-CやSIGTERMで実行中のフィード取得やAI呼び出しを中断できるようにする
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	return rootCmd.ExecuteContext(ctx)
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"strings"
 
 	"github.com/canpok1/ai-feed/internal/domain"
 	"github.com/canpok1/ai-feed/internal/domain/entity"
@@ -128,6 +129,18 @@ func printSummary(stdout io.Writer, summary domain.ConfigSummary) {
 // printAISummary はAI設定のサマリーを出力する
 func printAISummary(stdout io.Writer, summary domain.ConfigSummary) {
 	fmt.Fprintln(stdout, "AI設定:")
+	if len(summary.AIChain) > 0 {
+		fmt.Fprintf(stdout, "  - 呼び出し順: %s\n", strings.Join(summary.AIChain, " → "))
+		return
+	}
+	if summary.OpenAIConfigured {
+		fmt.Fprintf(stdout, "  - OpenAI互換API: 設定済み（モデル: %s、URL: %s）\n", summary.OpenAIModel, summary.OpenAIBaseURL)
+		return
+	}
+	if summary.OllamaConfigured {
+		fmt.Fprintf(stdout, "  - Ollama: 設定済み（モデル: %s、URL: %s）\n", summary.OllamaModel, summary.OllamaBaseURL)
+		return
+	}
 	if summary.GeminiConfigured {
 		fmt.Fprintf(stdout, "  - Gemini API: 設定済み（モデル: %s）\n", summary.GeminiModel)
 	} else {
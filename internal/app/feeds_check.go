@@ -0,0 +1,150 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/canpok1/ai-feed/internal/domain"
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+)
+
+// feeds checkコマンドの出力形式
+const (
+	// FeedsCheckFormatTable は表形式での出力を表す
+	FeedsCheckFormatTable = "table"
+	// FeedsCheckFormatJSON はJSON形式での出力を表す
+	FeedsCheckFormatJSON = "json"
+)
+
+// ErrNoFeedsToCheck は検査するフィードがない場合のsentinel error
+var ErrNoFeedsToCheck = errors.New("no feeds to check")
+
+// ErrBrokenFeeds は取得または解析に失敗したフィードがある場合のsentinel error
+var ErrBrokenFeeds = errors.New("broken feeds found")
+
+// feedCheckReport はJSON形式で出力するフィードの検査結果
+type feedCheckReport struct {
+	URL              string     `json:"url"`
+	Name             string     `json:"name,omitempty"`
+	Status           string     `json:"status"`
+	ItemCount        int        `json:"item_count"`
+	ItemsWithContent int        `json:"items_with_content"`
+	LatestPublished  *time.Time `json:"latest_published,omitempty"`
+	Error            string     `json:"error,omitempty"`
+	DurationMillis   int64      `json:"duration_ms"`
+}
+
+// FeedsCheckRunner はfeeds checkコマンドのビジネスロジックを実行する構造体
+type FeedsCheckRunner struct {
+	checker *domain.FeedChecker
+	stderr  io.Writer
+}
+
+// NewFeedsCheckRunner はFeedsCheckRunnerの新しいインスタンスを作成する
+func NewFeedsCheckRunner(checker *domain.FeedChecker, stderr io.Writer) *FeedsCheckRunner {
+	return &FeedsCheckRunner{
+		checker: checker,
+		stderr:  stderr,
+	}
+}
+
+// Run は有効なフィードをすべて検査し、結果をformatの形式でoutに書き出す
+// 取得または解析に失敗したフィードがある場合はErrBrokenFeedsを返す
+func (r *FeedsCheckRunner) Run(ctx context.Context, out io.Writer, feeds []entity.Feed, format string) error {
+	enabledFeeds := entity.FilterEnabledFeeds(feeds)
+	if len(enabledFeeds) == 0 {
+		return ErrNoFeedsToCheck
+	}
+
+	// 進行状況メッセージ: 検査開始
+	fmt.Fprintf(r.stderr, "フィードを検査しています... (%d件)\n", len(enabledFeeds))
+
+	results, err := r.checker.Check(ctx, enabledFeeds)
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case FeedsCheckFormatJSON:
+		err = writeFeedCheckJSON(out, results)
+	default:
+		err = writeFeedCheckTable(out, results)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write check report: %w", err)
+	}
+
+	brokenCount := 0
+	for i := range results {
+		if results[i].IsBroken() {
+			brokenCount++
+			slog.Warn("Broken feed found", "url", results[i].Feed.URL, "error", results[i].Err)
+		}
+	}
+	if brokenCount > 0 {
+		return fmt.Errorf("%w: %d/%d", ErrBrokenFeeds, brokenCount, len(results))
+	}
+
+	slog.Debug("All feeds checked successfully", "feed_count", len(results))
+	return nil
+}
+
+// writeFeedCheckTable は検査結果を表形式で書き出す
+func writeFeedCheckTable(out io.Writer, results []domain.FeedCheckResult) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "STATUS\tITEMS\tWITH CONTENT\tLATEST PUBLISHED\tERROR\tURL")
+	for i := range results {
+		result := &results[i]
+		latestPublished := "-"
+		if result.LatestPublished != nil {
+			latestPublished = formatStatusTime(*result.LatestPublished)
+		}
+		errMessage := "-"
+		if result.Err != nil {
+			// 表が崩れないように改行やタブを空白にまとめる
+			errMessage = truncateRunes(strings.Join(strings.Fields(result.Err.Error()), " "), maxLastErrorLength)
+		}
+
+		fmt.Fprintf(w, "%s\t%d\t%d\t%s\t%s\t%s\n",
+			result.Status,
+			result.ItemCount,
+			result.ItemsWithContent,
+			latestPublished,
+			errMessage,
+			result.Feed.URL,
+		)
+	}
+	return w.Flush()
+}
+
+// writeFeedCheckJSON は検査結果をJSON形式で書き出す
+func writeFeedCheckJSON(out io.Writer, results []domain.FeedCheckResult) error {
+	reports := make([]feedCheckReport, 0, len(results))
+	for i := range results {
+		result := &results[i]
+		report := feedCheckReport{
+			URL:              result.Feed.URL,
+			Name:             result.Feed.Name,
+			Status:           string(result.Status),
+			ItemCount:        result.ItemCount,
+			ItemsWithContent: result.ItemsWithContent,
+			LatestPublished:  result.LatestPublished,
+			DurationMillis:   result.Duration.Milliseconds(),
+		}
+		if result.Err != nil {
+			report.Error = result.Err.Error()
+		}
+		reports = append(reports, report)
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(reports)
+}
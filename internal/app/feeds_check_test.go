@@ -0,0 +1,102 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/canpok1/ai-feed/internal/domain"
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"github.com/canpok1/ai-feed/internal/domain/mock_domain"
+	"github.com/canpok1/ai-feed/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFeedsCheckRunner_Run(t *testing.T) {
+	published := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	okFeed := entity.Feed{URL: "https://ok.example.com/feed", Name: "OK"}
+	brokenFeed := entity.Feed{URL: "https://broken.example.com/feed"}
+	disabledFeed := entity.Feed{URL: "https://disabled.example.com/feed", Enabled: testutil.BoolPtr(false)}
+
+	setupFetchClient := func(m *mock_domain.MockFetchClient) {
+		m.EXPECT().Fetch(gomock.Any(), okFeed).Return([]entity.Article{
+			{Title: "A", Published: &published, Content: "body"},
+			{Title: "B"},
+		}, nil).AnyTimes()
+		m.EXPECT().Fetch(gomock.Any(), brokenFeed).Return(nil, errors.New("404 Not Found")).AnyTimes()
+	}
+
+	tests := []struct {
+		name    string
+		feeds   []entity.Feed
+		format  string
+		wantErr error
+		verify  func(t *testing.T, output string)
+	}{
+		{
+			name:   "正常系: 表形式で出力する",
+			feeds:  []entity.Feed{okFeed, disabledFeed},
+			format: FeedsCheckFormatTable,
+			verify: func(t *testing.T, output string) {
+				assert.Regexp(t, `STATUS\s+ITEMS\s+WITH CONTENT\s+LATEST PUBLISHED\s+ERROR\s+URL`, output)
+				assert.Regexp(t, `ok\s+2\s+1\s+\S+ \S+\s+-\s+https://ok.example.com/feed`, output)
+				assert.NotContains(t, output, "disabled.example.com")
+			},
+		},
+		{
+			name:    "異常系: 壊れたフィードがある場合はJSONを出力してエラーを返す",
+			feeds:   []entity.Feed{okFeed, brokenFeed},
+			format:  FeedsCheckFormatJSON,
+			wantErr: ErrBrokenFeeds,
+			verify: func(t *testing.T, output string) {
+				var reports []feedCheckReport
+				require.NoError(t, json.Unmarshal([]byte(output), &reports))
+				require.Len(t, reports, 2)
+				assert.Equal(t, "ok", reports[0].Status)
+				assert.Equal(t, "OK", reports[0].Name)
+				assert.Equal(t, 2, reports[0].ItemCount)
+				assert.Equal(t, 1, reports[0].ItemsWithContent)
+				require.NotNil(t, reports[0].LatestPublished)
+				assert.True(t, published.Equal(*reports[0].LatestPublished))
+				assert.Equal(t, "error", reports[1].Status)
+				assert.Equal(t, "404 Not Found", reports[1].Error)
+			},
+		},
+		{
+			name:    "異常系: 有効なフィードがない",
+			feeds:   []entity.Feed{disabledFeed},
+			format:  FeedsCheckFormatTable,
+			wantErr: ErrNoFeedsToCheck,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockFetchClient := mock_domain.NewMockFetchClient(ctrl)
+			setupFetchClient(mockFetchClient)
+
+			checker := domain.NewFeedChecker(mockFetchClient, domain.DefaultFetcherOptions())
+			runner := NewFeedsCheckRunner(checker, new(bytes.Buffer))
+			out := new(bytes.Buffer)
+
+			err := runner.Run(context.Background(), out, tt.feeds, tt.format)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			if tt.verify != nil {
+				tt.verify(t, out.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"text/tabwriter"
+
+	"github.com/canpok1/ai-feed/internal/domain"
+)
+
+// FeedsDiscoverRunner はfeeds discoverコマンドのビジネスロジックを実行する構造体
+type FeedsDiscoverRunner struct {
+	discoverer domain.FeedDiscoverer
+	stderr     io.Writer
+}
+
+// NewFeedsDiscoverRunner はFeedsDiscoverRunnerの新しいインスタンスを作成する
+func NewFeedsDiscoverRunner(discoverer domain.FeedDiscoverer, stderr io.Writer) *FeedsDiscoverRunner {
+	return &FeedsDiscoverRunner{
+		discoverer: discoverer,
+		stderr:     stderr,
+	}
+}
+
+// Run はページのURLからフィードを探し、見つかったフィードを表形式でoutに書き出す
+// フィードが見つからない場合はdomain.ErrFeedNotDiscoveredを返す
+func (r *FeedsDiscoverRunner) Run(ctx context.Context, out io.Writer, pageURL string) error {
+	// 進行状況メッセージ: 検出開始
+	fmt.Fprintf(r.stderr, "フィードを探しています... (%s)\n", pageURL)
+
+	feeds, err := r.discoverer.Discover(ctx, pageURL)
+	if err != nil {
+		return fmt.Errorf("failed to discover feeds: %w", err)
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TYPE\tTITLE\tURL")
+	for _, feed := range feeds {
+		title := feed.Title
+		if title == "" {
+			title = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", feed.Type, title, feed.URL)
+	}
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("failed to write discovered feeds: %w", err)
+	}
+
+	fmt.Fprintf(r.stderr, "%d件のフィードが見つかりました。--url で指定するか、設定ファイルの feeds に追加してください。\n", len(feeds))
+	slog.Debug("Feeds discovered", "page_url", pageURL, "feed_count", len(feeds))
+	return nil
+}
@@ -0,0 +1,67 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/canpok1/ai-feed/internal/domain"
+	"github.com/canpok1/ai-feed/internal/domain/mock_domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFeedsDiscoverRunner_Run(t *testing.T) {
+	const pageURL = "https://example.com/blog/"
+
+	tests := []struct {
+		name      string
+		setupMock func(m *mock_domain.MockFeedDiscoverer)
+		wantErr   error
+		verify    func(t *testing.T, output string)
+	}{
+		{
+			name: "正常系: 見つかったフィードを表示する",
+			setupMock: func(m *mock_domain.MockFeedDiscoverer) {
+				m.EXPECT().Discover(gomock.Any(), pageURL).Return([]domain.DiscoveredFeed{
+					{URL: "https://example.com/blog/rss.xml", Title: "Example Blog", Type: "rss"},
+					{URL: "https://example.com/blog/atom.xml", Type: "atom"},
+				}, nil)
+			},
+			verify: func(t *testing.T, output string) {
+				assert.Regexp(t, `TYPE\s+TITLE\s+URL`, output)
+				assert.Regexp(t, `rss\s+Example Blog\s+https://example.com/blog/rss.xml`, output)
+				assert.Regexp(t, `atom\s+-\s+https://example.com/blog/atom.xml`, output)
+			},
+		},
+		{
+			name: "異常系: フィードが見つからない",
+			setupMock: func(m *mock_domain.MockFeedDiscoverer) {
+				m.EXPECT().Discover(gomock.Any(), pageURL).Return(nil, fmt.Errorf("%w: %s", domain.ErrFeedNotDiscovered, pageURL))
+			},
+			wantErr: domain.ErrFeedNotDiscovered,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockDiscoverer := mock_domain.NewMockFeedDiscoverer(ctrl)
+			tt.setupMock(mockDiscoverer)
+
+			out := new(bytes.Buffer)
+			err := NewFeedsDiscoverRunner(mockDiscoverer, new(bytes.Buffer)).Run(context.Background(), out, pageURL)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			tt.verify(t, out.String())
+		})
+	}
+}
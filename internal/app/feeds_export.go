@@ -0,0 +1,50 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+
+	"github.com/canpok1/ai-feed/internal/domain"
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+)
+
+// ErrNoFeedsToExport はエクスポートするフィードがない場合のsentinel error
+var ErrNoFeedsToExport = errors.New("no feeds to export")
+
+// FeedsExportRunner はfeeds exportコマンドのビジネスロジックを実行する構造体
+type FeedsExportRunner struct {
+	exporter domain.FeedExporter
+	stderr   io.Writer
+}
+
+// NewFeedsExportRunner はFeedsExportRunnerの新しいインスタンスを作成する
+func NewFeedsExportRunner(exporter domain.FeedExporter, stderr io.Writer) *FeedsExportRunner {
+	return &FeedsExportRunner{
+		exporter: exporter,
+		stderr:   stderr,
+	}
+}
+
+// Run は有効なフィードをエクスポート形式でoutに書き出す
+// 無効化されたフィード（enabled: false）は書き出さない
+func (r *FeedsExportRunner) Run(out io.Writer, feeds []entity.Feed) error {
+	enabledFeeds := entity.FilterEnabledFeeds(feeds)
+	if len(enabledFeeds) < len(feeds) {
+		slog.Info("Skipping disabled feeds", "disabled_count", len(feeds)-len(enabledFeeds))
+	}
+	if len(enabledFeeds) == 0 {
+		return ErrNoFeedsToExport
+	}
+
+	// 進行状況メッセージ: エクスポート開始
+	fmt.Fprintf(r.stderr, "フィードをエクスポートしています... (%d件)\n", len(enabledFeeds))
+
+	if err := r.exporter.Export(out, enabledFeeds); err != nil {
+		return fmt.Errorf("failed to export feeds: %w", err)
+	}
+
+	slog.Debug("Feeds exported successfully", "feed_count", len(enabledFeeds))
+	return nil
+}
@@ -0,0 +1,78 @@
+package app
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"github.com/canpok1/ai-feed/internal/domain/mock_domain"
+	"github.com/canpok1/ai-feed/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFeedsExportRunner_Run(t *testing.T) {
+	t.Parallel()
+
+	enabledFeed := entity.Feed{URL: "https://example.com/a.xml", Name: "A"}
+	disabledFeed := entity.Feed{URL: "https://example.com/b.xml", Enabled: testutil.BoolPtr(false)}
+
+	tests := []struct {
+		name       string
+		feeds      []entity.Feed
+		setupMock  func(m *mock_domain.MockFeedExporter)
+		wantErr    error
+		wantErrMsg string
+	}{
+		{
+			name:  "正常系: 有効なフィードのみエクスポートする",
+			feeds: []entity.Feed{enabledFeed, disabledFeed},
+			setupMock: func(m *mock_domain.MockFeedExporter) {
+				m.EXPECT().Export(gomock.Any(), []entity.Feed{enabledFeed}).Return(nil)
+			},
+		},
+		{
+			name:  "異常系: 有効なフィードがない",
+			feeds: []entity.Feed{disabledFeed},
+			setupMock: func(m *mock_domain.MockFeedExporter) {
+				m.EXPECT().Export(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantErr: ErrNoFeedsToExport,
+		},
+		{
+			name:  "異常系: 書き出しに失敗",
+			feeds: []entity.Feed{enabledFeed},
+			setupMock: func(m *mock_domain.MockFeedExporter) {
+				m.EXPECT().Export(gomock.Any(), gomock.Any()).Return(errors.New("write error"))
+			},
+			wantErrMsg: "failed to export feeds: write error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			exporter := mock_domain.NewMockFeedExporter(ctrl)
+			tt.setupMock(exporter)
+			stderr := &bytes.Buffer{}
+
+			err := NewFeedsExportRunner(exporter, stderr).Run(&bytes.Buffer{}, tt.feeds)
+
+			switch {
+			case tt.wantErr != nil:
+				assert.ErrorIs(t, err, tt.wantErr)
+			case tt.wantErrMsg != "":
+				assert.EqualError(t, err, tt.wantErrMsg)
+			default:
+				require.NoError(t, err)
+				assert.Contains(t, stderr.String(), "フィードをエクスポートしています... (1件)")
+			}
+		})
+	}
+}
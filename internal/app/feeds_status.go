@@ -0,0 +1,85 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/canpok1/ai-feed/internal/domain"
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+)
+
+// ErrNoFeedsToReport は状態を表示するフィードがない場合のsentinel error
+var ErrNoFeedsToReport = errors.New("no feeds to report")
+
+// maxLastErrorLength は状態一覧に表示するエラーメッセージの最大文字数
+const maxLastErrorLength = 60
+
+// FeedsStatusRunner はfeeds statusコマンドのビジネスロジックを実行する構造体
+type FeedsStatusRunner struct {
+	healthMonitor *domain.FeedHealthMonitor
+}
+
+// NewFeedsStatusRunner はFeedsStatusRunnerの新しいインスタンスを作成する
+func NewFeedsStatusRunner(healthMonitor *domain.FeedHealthMonitor) *FeedsStatusRunner {
+	return &FeedsStatusRunner{healthMonitor: healthMonitor}
+}
+
+// Run は有効なフィードの健全性を表形式でoutに書き出す
+func (r *FeedsStatusRunner) Run(out io.Writer, feeds []entity.Feed) error {
+	enabledFeeds := entity.FilterEnabledFeeds(feeds)
+	if len(enabledFeeds) == 0 {
+		return ErrNoFeedsToReport
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "STATUS\tFAILURES\tLAST SUCCESS\tITEMS\tQUARANTINED UNTIL\tLAST ERROR\tURL")
+	for _, feed := range enabledFeeds {
+		health := r.healthMonitor.Health(feed)
+		quarantinedUntil := "-"
+		if health.Status == domain.FeedHealthStatusQuarantined {
+			quarantinedUntil = formatStatusTime(health.State.QuarantinedUntil)
+		}
+		items := "-"
+		if !health.State.LastSuccessAt.IsZero() {
+			items = strconv.Itoa(health.State.LastItemCount)
+		}
+		lastError := "-"
+		if health.State.ConsecutiveFailures > 0 && health.State.LastError != "" {
+			// 表が崩れないように改行やタブを空白にまとめる
+			lastError = truncateRunes(strings.Join(strings.Fields(health.State.LastError), " "), maxLastErrorLength)
+		}
+
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\t%s\t%s\n",
+			health.Status,
+			health.State.ConsecutiveFailures,
+			formatStatusTime(health.State.LastSuccessAt),
+			items,
+			quarantinedUntil,
+			lastError,
+			feed.URL,
+		)
+	}
+	return w.Flush()
+}
+
+// formatStatusTime は日時をローカル時刻で表示用に整形する（未記録の場合は"-"）
+func formatStatusTime(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return t.Local().Format(time.DateTime)
+}
+
+// truncateRunes は文字列を最大文字数に切り詰める
+func truncateRunes(s string, maxLength int) string {
+	runes := []rune(s)
+	if len(runes) <= maxLength {
+		return s
+	}
+	return string(runes[:maxLength]) + "..."
+}
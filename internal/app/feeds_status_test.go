@@ -0,0 +1,69 @@
+package app
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/canpok1/ai-feed/internal/domain"
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"github.com/canpok1/ai-feed/internal/domain/mock_domain"
+	"github.com/canpok1/ai-feed/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFeedsStatusRunner_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	lastSuccessAt := time.Date(2024, 1, 1, 9, 0, 0, 0, time.Local)
+	mockStateStore := mock_domain.NewMockFeedStateStore(ctrl)
+	mockStateStore.EXPECT().Get("https://ok.example.com/feed").Return(domain.FeedState{
+		URL:           "https://ok.example.com/feed",
+		LastSuccessAt: lastSuccessAt,
+		LastItemCount: 15,
+	}, true)
+	mockStateStore.EXPECT().Get("https://broken.example.com/feed").Return(domain.FeedState{
+		URL:                 "https://broken.example.com/feed",
+		ConsecutiveFailures: 3,
+		LastError:           "http error: 404 Not Found\nbody omitted",
+		QuarantinedUntil:    time.Now().Add(time.Hour),
+	}, true)
+	mockStateStore.EXPECT().Get("https://new.example.com/feed").Return(domain.FeedState{}, false)
+
+	feeds := []entity.Feed{
+		{URL: "https://ok.example.com/feed"},
+		{URL: "https://broken.example.com/feed"},
+		{URL: "https://new.example.com/feed"},
+		{URL: "https://disabled.example.com/feed", Enabled: testutil.BoolPtr(false)},
+	}
+
+	runner := NewFeedsStatusRunner(domain.NewFeedHealthMonitor(mockStateStore, nil))
+	out := new(bytes.Buffer)
+	require.NoError(t, runner.Run(out, feeds))
+
+	lines := strings.Split(strings.TrimRight(out.String(), "\n"), "\n")
+	require.Len(t, lines, 4)
+	assert.Regexp(t, `^STATUS\s+FAILURES\s+LAST SUCCESS\s+ITEMS\s+QUARANTINED UNTIL\s+LAST ERROR\s+URL$`, lines[0])
+	assert.Regexp(t, `^ok\s+0\s+2024-01-01 09:00:00\s+15\s+-\s+-\s+https://ok.example.com/feed$`, lines[1])
+	assert.Regexp(t, `^quarantined\s+3\s+-\s+-\s+\S+ \S+\s+http error: 404 Not Found body omitted\s+https://broken.example.com/feed$`, lines[2])
+	assert.Regexp(t, `^unknown\s+0\s+-\s+-\s+-\s+-\s+https://new.example.com/feed$`, lines[3])
+}
+
+func TestFeedsStatusRunner_Run_NoFeeds(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	runner := NewFeedsStatusRunner(domain.NewFeedHealthMonitor(mock_domain.NewMockFeedStateStore(ctrl), nil))
+	err := runner.Run(new(bytes.Buffer), []entity.Feed{{URL: "https://example.com/feed", Enabled: testutil.BoolPtr(false)}})
+
+	assert.ErrorIs(t, err, ErrNoFeedsToReport)
+}
+
+func TestTruncateRunes(t *testing.T) {
+	assert.Equal(t, "あいう", truncateRunes("あいう", 3))
+	assert.Equal(t, "あい...", truncateRunes("あいう", 2))
+}
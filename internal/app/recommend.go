@@ -7,6 +7,8 @@ import (
 	"io"
 	"log/slog"
 	"math/rand/v2"
+	"strings"
+	"time"
 
 	"github.com/canpok1/ai-feed/internal/domain"
 	"github.com/canpok1/ai-feed/internal/domain/entity"
@@ -21,22 +23,31 @@ type MessageSenderFactory func(outputConfig *entity.OutputConfig) ([]domain.Mess
 // RecommendCacheFactory はRecommendCacheを作成するためのファクトリ関数型
 type RecommendCacheFactory func(cacheConfig *entity.CacheConfig) (domain.RecommendCache, error)
 
+// FeedSelectorFactory はFeedSelectorを作成するためのファクトリ関数型
+// least_recent戦略で推薦履歴を参照できるように、作成済みのキャッシュを受け取る
+// rngはランダムにフィードを選ぶ戦略で使う乱数生成器
+type FeedSelectorFactory func(cache domain.RecommendCache, rng *rand.Rand) (domain.FeedSelector, error)
+
 // RecommendParams はrecommendコマンドの実行パラメータを表す構造体
 type RecommendParams struct {
-	URLs []string
+	Feeds []entity.Feed
 }
 
 // RecommendRunner はrecommendコマンドのビジネスロジックを実行する構造体
 type RecommendRunner struct {
-	fetcher     *domain.Fetcher
-	recommender domain.Recommender
-	senders     []domain.MessageSender
-	cache       domain.RecommendCache
-	stderr      io.Writer
-	stdout      io.Writer
+	fetcher       *domain.Fetcher
+	feedSelector  domain.FeedSelector
+	healthMonitor *domain.FeedHealthMonitor
+	recommender   domain.Recommender
+	senders       []domain.MessageSender
+	cache         domain.RecommendCache
+	stderr        io.Writer
+	stdout        io.Writer
 }
 
 // NewRecommendRunner はRecommendRunnerの新しいインスタンスを作成する
+// healthMonitorがnilの場合はフィードの健全性を記録せず、除外も行わない
+// rngがnilの場合はランダムなシードの乱数生成器を使う
 func NewRecommendRunner(
 	fetchClient domain.FetchClient,
 	recommender domain.Recommender,
@@ -45,17 +56,27 @@ func NewRecommendRunner(
 	outputConfig *entity.OutputConfig,
 	promptConfig *entity.PromptConfig,
 	cacheConfig *entity.CacheConfig,
+	fetchConfig *entity.FetchConfig,
 	senderFactory MessageSenderFactory,
 	cacheFactory RecommendCacheFactory,
+	feedSelectorFactory FeedSelectorFactory,
+	healthMonitor *domain.FeedHealthMonitor,
+	rng *rand.Rand,
 ) (*RecommendRunner, error) {
-	fetcher := domain.NewFetcher(
+	// フィード取得設定の同時取得数とタイムアウトを使用する
+	fetcherOptions := domain.NewFetcherOptions(fetchConfig)
+	fetcherOptions.HealthMonitor = healthMonitor
+
+	fetcher := domain.NewFetcherWithOptions(
 		fetchClient,
 		func(url string, fetchErr error) error {
 			fmt.Fprintf(stderr, "エラー: フィードの取得に失敗しました: %s\n", url)
 			fmt.Fprintln(stderr, "フィードのURLが正しいか確認してください。サイトが一時的に利用できない可能性もあります。")
 			slog.Error("Failed to fetch feed", "url", url, "error", fetchErr)
-			return fetchErr
+			// 複数フィードをまとめて取得する場合に、1つの失敗で他のフィードの記事を捨てないよう取得を継続する
+			return nil
 		},
+		fetcherOptions,
 	)
 
 	// ファクトリ関数を使用してMessageSenderを作成
@@ -75,37 +96,106 @@ func NewRecommendRunner(
 		return nil, fmt.Errorf("failed to initialize cache: %w", initErr)
 	}
 
+	// ファクトリ関数を使用してフィード選択戦略を作成
+	if rng == nil {
+		rng = domain.NewRandom(domain.NewRandomSeed())
+	}
+	feedSelector, selectorErr := feedSelectorFactory(articleCache, rng)
+	if selectorErr != nil {
+		_ = articleCache.Close()
+		return nil, fmt.Errorf("failed to create feed selector: %w", selectorErr)
+	}
+
 	return &RecommendRunner{
-		fetcher:     fetcher,
-		recommender: recommender,
-		senders:     senders,
-		cache:       articleCache,
-		stderr:      stderr,
-		stdout:      stdout,
+		fetcher:       fetcher,
+		feedSelector:  feedSelector,
+		healthMonitor: healthMonitor,
+		recommender:   recommender,
+		senders:       senders,
+		cache:         articleCache,
+		stderr:        stderr,
+		stdout:        stdout,
 	}, nil
 }
 
-// selectRandomFeed は利用可能なfeed URLからランダムに1つを選択する
-func selectRandomFeed(urls []string, excludedURLs map[string]bool) (string, error) {
-	var availableURLs []string
-	for _, url := range urls {
-		if !excludedURLs[url] {
-			availableURLs = append(availableURLs, url)
+// filterAvailableFeeds は除外済みのURLを除いたfeedを返す
+func filterAvailableFeeds(feeds []entity.Feed, excludedURLs map[string]bool) []entity.Feed {
+	var availableFeeds []entity.Feed
+	for _, feed := range feeds {
+		if !excludedURLs[feed.URL] {
+			availableFeeds = append(availableFeeds, feed)
 		}
 	}
+	return availableFeeds
+}
+
+// excludeQuarantinedFeeds は除外期間中のフィードを取り除き、除外したフィードを通知する
+func (r *RecommendRunner) excludeQuarantinedFeeds(feeds []entity.Feed) []entity.Feed {
+	if r.healthMonitor == nil {
+		return feeds
+	}
+
+	available, quarantined := r.healthMonitor.FilterAvailable(feeds)
+	for _, health := range quarantined {
+		fmt.Fprintf(r.stderr, "連続して取得に失敗しているためスキップします: %s (%sまで)\n",
+			health.Feed.URL, health.State.QuarantinedUntil.Local().Format(time.DateTime))
+		slog.Info("Skipping quarantined feed",
+			"url", health.Feed.URL,
+			"consecutive_failures", health.State.ConsecutiveFailures,
+			"quarantined_until", health.State.QuarantinedUntil)
+	}
+	return available
+}
 
-	if len(availableURLs) == 0 {
-		return "", errors.New("no available feeds")
+// mergeDuplicateArticles は重複する記事をまとめ、まとめた記事を通知する
+func (r *RecommendRunner) mergeDuplicateArticles(deduplicator *domain.ArticleDeduplicator, articles []entity.Article) []entity.Article {
+	merged, groups := deduplicator.Apply(articles)
+	if len(groups) == 0 {
+		return articles
 	}
 
-	return availableURLs[rand.IntN(len(availableURLs))], nil
+	for _, group := range groups {
+		duplicateURLs := make([]string, 0, len(group.Duplicates))
+		for _, duplicate := range group.Duplicates {
+			duplicateURLs = append(duplicateURLs, duplicate.Link)
+		}
+		slog.Info("Merged duplicate articles",
+			"url", group.Kept.Link,
+			"title", group.Kept.Title,
+			"feeds", group.Kept.SourceFeedURLs,
+			"duplicate_urls", duplicateURLs)
+	}
+	fmt.Fprintf(r.stderr, "重複する記事を%d件まとめました\n", len(articles)-len(merged))
+	return merged
+}
+
+// suppressRecentTopics は最近推薦した記事と同じ話題の記事を外し（demoteの場合は後回しにし）、該当した記事を通知する
+func (r *RecommendRunner) suppressRecentTopics(suppressor *domain.TopicSuppressor, action string, articles []entity.Article) []entity.Article {
+	kept, suppressed := suppressor.Apply(articles)
+	for _, article := range suppressed {
+		slog.Info("Article suppressed as a recently recommended topic",
+			"action", action,
+			"url", article.Article.Link,
+			"title", article.Article.Title,
+			"similar_url", article.Similar.URL,
+			"similar_title", article.Similar.Title,
+			"similarity", article.Similarity)
+	}
+	if len(suppressed) > 0 {
+		if action == entity.TopicSuppressionActionDemote {
+			fmt.Fprintf(r.stderr, "最近推薦した記事と同じ話題の記事%d件を推薦候補の後ろに回しました\n", len(suppressed))
+		} else {
+			fmt.Fprintf(r.stderr, "最近推薦した記事と同じ話題の記事を%d件除外しました\n", len(suppressed))
+		}
+	}
+	return kept
 }
 
 // Run はrecommendコマンドのビジネスロジックを実行する
 func (r *RecommendRunner) Run(ctx context.Context, params *RecommendParams, profile *entity.Profile) error {
 	slog.Debug("RecommendRunner.Run parameters", slog.Any("profile", profile))
 	slog.Info("Starting recommend command execution")
-	slog.Debug("Selecting feed from URLs", "url_count", len(params.URLs))
+	slog.Debug("Selecting feed from feeds", "feed_count", len(params.Feeds))
 
 	// キャッシュのリソース管理
 	defer func() {
@@ -114,34 +204,51 @@ func (r *RecommendRunner) Run(ctx context.Context, params *RecommendParams, prof
 		}
 	}()
 
-	// 複数feedからの2段階ランダム選択とリトライロジック
+	// 連続して取得に失敗しているフィードは除外期間中は選択しない
+	feeds := r.excludeQuarantinedFeeds(params.Feeds)
+	if len(feeds) == 0 {
+		fmt.Fprintln(r.stderr, "すべてのフィードが連続した取得失敗により一時的に除外されています")
+		fmt.Fprintln(r.stderr, "`ai-feed feeds status` でフィードの状態を確認してください。")
+		slog.Error("All feeds are quarantined", "feed_count", len(params.Feeds))
+		return ErrNoArticlesFound
+	}
+
+	// フィード選択戦略による2段階選択とリトライロジック
 	excludedURLs := make(map[string]bool)
 	var allArticles []entity.Article
-	var selectedURL string
 
-	for attempt := 1; attempt <= len(params.URLs); attempt++ {
+	for attempt := 1; attempt <= len(feeds); attempt++ {
 		// 進行状況メッセージ: フィード選択
 		if attempt == 1 {
 			fmt.Fprintln(r.stderr, "フィードを選択しています...")
 		} else {
-			fmt.Fprintf(r.stderr, "別のフィードで再試行しています... (%d/%d)\n", attempt, len(params.URLs))
+			fmt.Fprintf(r.stderr, "別のフィードで再試行しています... (%d/%d)\n", attempt, len(feeds))
 		}
 
-		// Step 1: ランダムに1つのfeedを選択
-		var err error
-		selectedURL, err = selectRandomFeed(params.URLs, excludedURLs)
+		// Step 1: フィード選択戦略に従ってfeedを選択
+		selectedFeeds, err := r.feedSelector.Select(filterAvailableFeeds(feeds, excludedURLs))
 		if err != nil {
-			slog.Error("Failed to select feed", "error", err, "attempt", attempt, "total_feeds", len(params.URLs))
+			slog.Error("Failed to select feed", "error", err, "attempt", attempt, "total_feeds", len(feeds))
 			break
 		}
+		selectedURLs := make([]string, 0, len(selectedFeeds))
+		for _, feed := range selectedFeeds {
+			selectedURLs = append(selectedURLs, feed.URL)
+		}
 
-		slog.Debug("Selected feed for articles fetch", "url", selectedURL, "attempt", attempt)
+		slog.Debug("Selected feeds for articles fetch", "urls", selectedURLs, "attempt", attempt)
 
 		// 進行状況メッセージ: フィード取得
-		fmt.Fprintf(r.stderr, "フィードを取得しています... (%sから)\n", selectedURL)
+		fmt.Fprintf(r.stderr, "フィードを取得しています... (%sから)\n", strings.Join(selectedURLs, ", "))
 
 		// Step 2: 選択されたfeedから記事を取得
-		allArticles, err = r.fetcher.Fetch([]string{selectedURL}, 0)
+		allArticles, err = r.fetcher.Fetch(ctx, selectedFeeds, 0)
+
+		// 中断（Ctrl-Cなど）された場合は別のフィードで再試行しない
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			slog.Warn("Recommend interrupted while fetching feed", "urls", selectedURLs, "error", ctxErr)
+			return fmt.Errorf("recommend interrupted: %w", ctxErr)
+		}
 
 		// エラーまたは記事0件の場合は失敗として次のフィードを試す
 		var shouldRetry bool
@@ -150,28 +257,30 @@ func (r *RecommendRunner) Run(ctx context.Context, params *RecommendParams, prof
 			shouldRetry = true
 			logMessage = "Failed to fetch from feed, retrying with another feed"
 			slog.Warn(logMessage,
-				"url", selectedURL,
+				"urls", selectedURLs,
 				"error", err.Error(),
 				"attempt", attempt,
-				"total_feeds", len(params.URLs))
+				"total_feeds", len(feeds))
 		} else if len(allArticles) == 0 {
 			shouldRetry = true
 			logMessage = "No articles found in feed, retrying with another feed"
 			slog.Warn(logMessage,
-				"url", selectedURL,
+				"urls", selectedURLs,
 				"attempt", attempt,
-				"total_feeds", len(params.URLs))
+				"total_feeds", len(feeds))
 		}
 
 		if shouldRetry {
-			excludedURLs[selectedURL] = true
+			for _, url := range selectedURLs {
+				excludedURLs[url] = true
+			}
 			continue
 		}
 
 		// 成功した場合
 		// 進行状況メッセージ: 記事解析
 		fmt.Fprintf(r.stderr, "記事を解析しています... (%d件の記事を発見)\n", len(allArticles))
-		slog.Info("Successfully fetched articles from feed", "url", selectedURL, "article_count", len(allArticles))
+		slog.Info("Successfully fetched articles from feed", "urls", selectedURLs, "article_count", len(allArticles))
 		break
 	}
 
@@ -185,6 +294,15 @@ func (r *RecommendRunner) Run(ctx context.Context, params *RecommendParams, prof
 		return ErrNoArticlesFound
 	}
 
+	// トラッキング用パラメータなどを取り除き、重複チェックと投稿に正規化したURLを使う
+	urlNormalizer := domain.NewURLNormalizer(profile.CanonicalURL)
+	allArticles = urlNormalizer.NormalizeArticles(allArticles)
+
+	// 複数のフィードが配信している同じ記事を1件にまとめる
+	if profile.Dedup.IsEnabled() {
+		allArticles = r.mergeDuplicateArticles(domain.NewArticleDeduplicator(profile.Dedup, urlNormalizer), allArticles)
+	}
+
 	// 記事の重複チェックとフィルタリング
 	fmt.Fprintln(r.stderr, "記事の重複をチェックしています...")
 	var uniqueArticles []entity.Article
@@ -214,6 +332,41 @@ func (r *RecommendRunner) Run(ctx context.Context, params *RecommendParams, prof
 
 	fmt.Fprintf(r.stderr, "%d件の新しい記事が見つかりました\n", len(uniqueArticles))
 
+	// 最近推薦した記事と同じ話題の記事を推薦候補から外す
+	if profile.TopicSuppression.IsEnabled() {
+		uniqueArticles = r.suppressRecentTopics(domain.NewTopicSuppressor(r.cache, profile.TopicSuppression), profile.TopicSuppression.ActionOrDefault(), uniqueArticles)
+		if len(uniqueArticles) == 0 {
+			slog.Info("All articles are similar to recently recommended articles")
+			fmt.Fprintln(r.stdout, "最近推薦した記事と話題の異なる新しい記事が見つかりませんでした。")
+			return nil
+		}
+	}
+
+	// プロファイルのフィルタ条件で推薦候補を絞り込む
+	// 選択後に記事ページから本文を取得する記事は、フィードの本文の短さでは除外しない
+	articleFilter, err := domain.NewArticleFilter(profile.Filters)
+	if err != nil {
+		return fmt.Errorf("failed to create article filter: %w", err)
+	}
+	articleFilter = articleFilter.WithFullText(profile.FullText, params.Feeds)
+	filteredArticles, droppedArticles := articleFilter.Apply(uniqueArticles)
+	for _, dropped := range droppedArticles {
+		slog.Info("Article dropped by filter",
+			"url", dropped.Article.Link,
+			"title", dropped.Article.Title,
+			"reason", dropped.Reason)
+	}
+	if len(droppedArticles) > 0 {
+		fmt.Fprintf(r.stderr, "フィルタ条件により%d件の記事を除外しました\n", len(droppedArticles))
+	}
+
+	if len(filteredArticles) == 0 {
+		slog.Info("All articles are dropped by filters", "dropped_articles", len(droppedArticles))
+		fmt.Fprintln(r.stdout, "フィルタ条件に一致する新しい記事が見つかりませんでした。")
+		return nil
+	}
+	uniqueArticles = filteredArticles
+
 	// 進行状況メッセージ: 記事選定とコメント生成の開始
 	fmt.Fprintln(r.stderr, "記事選定とコメント生成を行なっています...")
 
@@ -283,7 +436,7 @@ func (r *RecommendRunner) Run(ctx context.Context, params *RecommendParams, prof
 
 	// 全ての投稿が成功した場合のみキャッシュを更新
 	fmt.Fprintln(r.stderr, "投稿履歴をキャッシュに保存しています...")
-	if err := r.cache.AddEntry(recommend.Article.Link, recommend.Article.Title); err != nil {
+	if err := r.cache.AddEntry(recommend.Article); err != nil {
 		slog.Error("Failed to update cache", "url", recommend.Article.Link, "title", recommend.Article.Title, "error", err)
 		// キャッシュ更新の失敗は致命的エラーとしない（投稿は成功しているため）
 		fmt.Fprintf(r.stderr, "警告: キャッシュの更新に失敗しましたが、投稿は完了しました\n")
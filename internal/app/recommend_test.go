@@ -6,7 +6,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"math/rand/v2"
 	"testing"
+	"time"
 
 	"github.com/canpok1/ai-feed/internal/domain"
 	"github.com/canpok1/ai-feed/internal/domain/entity"
@@ -37,10 +39,19 @@ func testRecommendCacheFactory(cacheConfig *entity.CacheConfig) (domain.Recommen
 // mockNopCache はテスト用のノーオペレーションキャッシュ
 type mockNopCache struct{}
 
-func (c *mockNopCache) Initialize() error                { return nil }
-func (c *mockNopCache) IsCached(url string) bool         { return false }
-func (c *mockNopCache) AddEntry(url, title string) error { return nil }
-func (c *mockNopCache) Close() error                     { return nil }
+func (c *mockNopCache) Initialize() error                     { return nil }
+func (c *mockNopCache) IsCached(url string) bool              { return false }
+func (c *mockNopCache) AddEntry(article entity.Article) error { return nil }
+func (c *mockNopCache) Close() error                          { return nil }
+func (c *mockNopCache) LastRecommendedAt(feedURL string) (time.Time, bool) {
+	return time.Time{}, false
+}
+func (c *mockNopCache) RecentEntries(since time.Time) []domain.RecommendEntry { return nil }
+
+// testFeedSelectorFactory はテスト用のFeedSelectorFactoryを返す
+func testFeedSelectorFactory(cache domain.RecommendCache, rng *rand.Rand) (domain.FeedSelector, error) {
+	return &domain.RandomFeedSelector{}, nil
+}
 
 // createMockConfig はテスト用にモックのentity.Profileを作成する。
 func createMockConfig(promptConfig *entity.PromptConfig, outputConfig *entity.OutputConfig) *entity.Profile {
@@ -116,8 +127,12 @@ func TestNewRecommendRunner(t *testing.T) {
 				tt.outputConfig,
 				tt.promptConfig,
 				nil, // cacheConfig
+				nil, // fetchConfig
 				testMessageSenderFactory,
 				testRecommendCacheFactory,
+				testFeedSelectorFactory,
+				nil,
+				nil,
 			)
 
 			if tt.expectError {
@@ -162,7 +177,7 @@ func TestRecommendRunner_Run(t *testing.T) {
 		{
 			name: "正常系: 推薦成功",
 			mockFetchClientExpectations: func(m *mock_domain.MockFetchClient) {
-				m.EXPECT().Fetch(gomock.Any()).Return([]entity.Article{
+				m.EXPECT().Fetch(gomock.Any(), gomock.Any()).Return([]entity.Article{
 					{Title: "Test Article", Link: "http://example.com/test"},
 				}, nil).Times(1)
 			},
@@ -175,14 +190,14 @@ func TestRecommendRunner_Run(t *testing.T) {
 			outputConfig: defaultOutputConfig,
 			promptConfig: defaultPromptConfig,
 			params: &RecommendParams{
-				URLs: []string{"http://example.com/feed.xml"},
+				Feeds: entity.NewFeedsFromURLs([]string{"http://example.com/feed.xml"}),
 			},
 			expectedErrorMessage: nil,
 		},
 		{
 			name: "異常系: 記事が見つからない",
 			mockFetchClientExpectations: func(m *mock_domain.MockFetchClient) {
-				m.EXPECT().Fetch(gomock.Any()).Return([]entity.Article{}, nil).Times(1)
+				m.EXPECT().Fetch(gomock.Any(), gomock.Any()).Return([]entity.Article{}, nil).Times(1)
 			},
 			mockRecommenderExpectations: func(m *mock_domain.MockRecommender) {
 				// 記事が見つからない場合は呼び出されない
@@ -192,14 +207,14 @@ func TestRecommendRunner_Run(t *testing.T) {
 			outputConfig: defaultOutputConfig,
 			promptConfig: defaultPromptConfig,
 			params: &RecommendParams{
-				URLs: []string{"http://example.com/empty.xml"},
+				Feeds: entity.NewFeedsFromURLs([]string{"http://example.com/empty.xml"}),
 			},
 			expectedErrorMessage: testutil.StringPtr("no articles found in the feed"),
 		},
 		{
 			name: "異常系: フェッチエラー",
 			mockFetchClientExpectations: func(m *mock_domain.MockFetchClient) {
-				m.EXPECT().Fetch(gomock.Any()).Return(nil, fmt.Errorf("mock fetch error")).Times(1)
+				m.EXPECT().Fetch(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("mock fetch error")).Times(1)
 			},
 			mockRecommenderExpectations: func(m *mock_domain.MockRecommender) {
 				m.EXPECT().Recommend(gomock.Any(), gomock.Any()).Times(0)
@@ -208,14 +223,14 @@ func TestRecommendRunner_Run(t *testing.T) {
 			outputConfig: defaultOutputConfig,
 			promptConfig: defaultPromptConfig,
 			params: &RecommendParams{
-				URLs: []string{"http://invalid.com/feed.xml"},
+				Feeds: entity.NewFeedsFromURLs([]string{"http://invalid.com/feed.xml"}),
 			},
 			expectedErrorMessage: testutil.StringPtr("no articles found in the feed"),
 		},
 		{
 			name: "異常系: 推薦エラー",
 			mockFetchClientExpectations: func(m *mock_domain.MockFetchClient) {
-				m.EXPECT().Fetch(gomock.Any()).Return([]entity.Article{
+				m.EXPECT().Fetch(gomock.Any(), gomock.Any()).Return([]entity.Article{
 					{Title: "Test Article", Link: "http://example.com/test"},
 				}, nil).Times(1)
 			},
@@ -226,14 +241,14 @@ func TestRecommendRunner_Run(t *testing.T) {
 			outputConfig: defaultOutputConfig,
 			promptConfig: defaultPromptConfig,
 			params: &RecommendParams{
-				URLs: []string{"http://example.com/feed.xml"},
+				Feeds: entity.NewFeedsFromURLs([]string{"http://example.com/feed.xml"}),
 			},
 			expectedErrorMessage: testutil.StringPtr("failed to recommend article: mock recommend error"),
 		},
 		{
 			name: "正常系: AIモデル未設定",
 			mockFetchClientExpectations: func(m *mock_domain.MockFetchClient) {
-				m.EXPECT().Fetch(gomock.Any()).Return([]entity.Article{
+				m.EXPECT().Fetch(gomock.Any(), gomock.Any()).Return([]entity.Article{
 					{Title: "Test Article", Link: "http://example.com/test"}}, nil).AnyTimes()
 			},
 			mockRecommenderExpectations: func(m *mock_domain.MockRecommender) {
@@ -250,14 +265,14 @@ func TestRecommendRunner_Run(t *testing.T) {
 			outputConfig: defaultOutputConfig,
 			promptConfig: defaultPromptConfig,
 			params: &RecommendParams{
-				URLs: []string{"http://example.com/feed.xml"},
+				Feeds: entity.NewFeedsFromURLs([]string{"http://example.com/feed.xml"}),
 			},
 			expectedErrorMessage: nil,
 		},
 		{
 			name: "正常系: プロンプト未設定",
 			mockFetchClientExpectations: func(m *mock_domain.MockFetchClient) {
-				m.EXPECT().Fetch(gomock.Any()).Return([]entity.Article{
+				m.EXPECT().Fetch(gomock.Any(), gomock.Any()).Return([]entity.Article{
 					{Title: "Test Article", Link: "http://example.com/test"}}, nil).AnyTimes()
 			},
 			mockRecommenderExpectations: func(m *mock_domain.MockRecommender) {
@@ -274,7 +289,7 @@ func TestRecommendRunner_Run(t *testing.T) {
 			outputConfig: defaultOutputConfig,
 			promptConfig: defaultPromptConfig,
 			params: &RecommendParams{
-				URLs: []string{"http://example.com/feed.xml"},
+				Feeds: entity.NewFeedsFromURLs([]string{"http://example.com/feed.xml"}),
 			},
 			expectedErrorMessage: nil,
 		},
@@ -295,7 +310,7 @@ func TestRecommendRunner_Run(t *testing.T) {
 			stdoutBuffer := new(bytes.Buffer)
 
 			profile := tt.setupProfile()
-			runner, runErr := NewRecommendRunner(mockFetchClient, mockRecommender, stderrBuffer, stdoutBuffer, tt.outputConfig, tt.promptConfig, nil, testMessageSenderFactory, testRecommendCacheFactory)
+			runner, runErr := NewRecommendRunner(mockFetchClient, mockRecommender, stderrBuffer, stdoutBuffer, tt.outputConfig, tt.promptConfig, nil, nil, testMessageSenderFactory, testRecommendCacheFactory, testFeedSelectorFactory, nil, nil)
 
 			ctx := context.Background()
 
@@ -336,7 +351,7 @@ func TestRecommendRunner_Run_LogOutput(t *testing.T) {
 	mockProfile := createMockConfig(&entity.PromptConfig{CommentPromptTemplate: "test-prompt-template", FixedMessage: "Test Fixed Message"}, &entity.OutputConfig{})
 
 	stdoutBuffer := new(bytes.Buffer)
-	runner, runErr := NewRecommendRunner(mockFetchClient, mockRecommender, stderrBuffer, stdoutBuffer, mockProfile.Output, mockProfile.Prompt, nil, testMessageSenderFactory, testRecommendCacheFactory)
+	runner, runErr := NewRecommendRunner(mockFetchClient, mockRecommender, stderrBuffer, stdoutBuffer, mockProfile.Output, mockProfile.Prompt, nil, nil, testMessageSenderFactory, testRecommendCacheFactory, testFeedSelectorFactory, nil, nil)
 	assert.NoError(t, runErr)
 
 	// テストデータをセットアップ
@@ -350,11 +365,15 @@ func TestRecommendRunner_Run_LogOutput(t *testing.T) {
 	}
 
 	// モックの期待値をセットアップ
-	mockFetchClient.EXPECT().Fetch(gomock.Any()).Return(testArticles, nil)
-	mockRecommender.EXPECT().Recommend(gomock.Any(), testArticles).Return(testRecommend, nil)
+	mockFetchClient.EXPECT().Fetch(gomock.Any(), gomock.Any()).Return(testArticles, nil)
+	// 取得した記事には取得元フィードの情報が設定される
+	wantArticles := []entity.Article{
+		{Title: "Test Article", Link: "https://example.com/test", FeedURL: "https://example.com/feed", FeedName: "https://example.com/feed"},
+	}
+	mockRecommender.EXPECT().Recommend(gomock.Any(), wantArticles).Return(testRecommend, nil)
 
 	// テストを実行
-	params := &RecommendParams{URLs: []string{"https://example.com/feed"}}
+	params := &RecommendParams{Feeds: entity.NewFeedsFromURLs([]string{"https://example.com/feed"})}
 	profile := mockProfile
 	err := runner.Run(context.Background(), params, profile)
 
@@ -386,6 +405,181 @@ func TestRecommendRunner_Run_LogOutput(t *testing.T) {
 	assert.Equal(t, "Test Fixed Message", logEntry["fixed_message"])
 }
 
+func TestRecommendRunner_Run_FeedSelectionAll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFetchClient := mock_domain.NewMockFetchClient(ctrl)
+	mockRecommender := mock_domain.NewMockRecommender(ctrl)
+
+	stderrBuffer := new(bytes.Buffer)
+	stdoutBuffer := new(bytes.Buffer)
+	mockProfile := createMockConfig(&entity.PromptConfig{CommentPromptTemplate: "test-prompt-template"}, &entity.OutputConfig{})
+
+	allFeedSelectorFactory := func(cache domain.RecommendCache, rng *rand.Rand) (domain.FeedSelector, error) {
+		return &domain.AllFeedSelector{}, nil
+	}
+	runner, runErr := NewRecommendRunner(mockFetchClient, mockRecommender, stderrBuffer, stdoutBuffer, mockProfile.Output, mockProfile.Prompt, nil, nil, testMessageSenderFactory, testRecommendCacheFactory, allFeedSelectorFactory, nil, nil)
+	require.NoError(t, runErr)
+
+	articleA := entity.Article{Title: "Article A", Link: "https://a.example.com/1"}
+	articleC := entity.Article{Title: "Article C", Link: "https://c.example.com/1"}
+
+	// 1つのフィードの取得に失敗しても、他のフィードの記事をまとめて推薦対象にする
+	mockFetchClient.EXPECT().Fetch(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, feed entity.Feed) ([]entity.Article, error) {
+			switch feed.URL {
+			case "https://a.example.com/feed":
+				return []entity.Article{articleA}, nil
+			case "https://c.example.com/feed":
+				return []entity.Article{articleC}, nil
+			default:
+				return nil, fmt.Errorf("mock fetch error")
+			}
+		}).Times(3)
+	mockRecommender.EXPECT().Recommend(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, articles []entity.Article) (*entity.Recommend, error) {
+			assert.Len(t, articles, 2)
+			return &entity.Recommend{Article: articles[0]}, nil
+		})
+
+	params := &RecommendParams{Feeds: entity.NewFeedsFromURLs([]string{
+		"https://a.example.com/feed",
+		"https://b.example.com/feed",
+		"https://c.example.com/feed",
+	})}
+	err := runner.Run(context.Background(), params, mockProfile)
+
+	require.NoError(t, err)
+	assert.Contains(t, stderrBuffer.String(), "エラー: フィードの取得に失敗しました: https://b.example.com/feed")
+	assert.Contains(t, stderrBuffer.String(), "記事を解析しています... (2件の記事を発見)")
+}
+
+func TestRecommendRunner_Run_SkipQuarantinedFeeds(t *testing.T) {
+	quarantinedState := domain.FeedState{
+		URL:                 "https://broken.example.com/feed",
+		ConsecutiveFailures: 3,
+		QuarantinedUntil:    time.Now().Add(time.Hour),
+	}
+
+	tests := []struct {
+		name          string
+		feeds         []entity.Feed
+		expectFetch   bool
+		expectedError error
+	}{
+		{
+			name: "除外期間中のフィードを選択しない",
+			feeds: entity.NewFeedsFromURLs([]string{
+				"https://broken.example.com/feed",
+				"https://ok.example.com/feed",
+			}),
+			expectFetch: true,
+		},
+		{
+			name:          "すべてのフィードが除外期間中",
+			feeds:         entity.NewFeedsFromURLs([]string{"https://broken.example.com/feed"}),
+			expectedError: ErrNoArticlesFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockFetchClient := mock_domain.NewMockFetchClient(ctrl)
+			mockRecommender := mock_domain.NewMockRecommender(ctrl)
+			mockStateStore := mock_domain.NewMockFeedStateStore(ctrl)
+			mockStateStore.EXPECT().Get("https://broken.example.com/feed").Return(quarantinedState, true).AnyTimes()
+			mockStateStore.EXPECT().Get(gomock.Any()).Return(domain.FeedState{}, false).AnyTimes()
+			mockStateStore.EXPECT().Update(gomock.Any()).Return(nil).AnyTimes()
+
+			stderrBuffer := new(bytes.Buffer)
+			stdoutBuffer := new(bytes.Buffer)
+			mockProfile := createMockConfig(&entity.PromptConfig{CommentPromptTemplate: "test-prompt-template"}, &entity.OutputConfig{})
+
+			healthMonitor := domain.NewFeedHealthMonitor(mockStateStore, nil)
+			runner, runErr := NewRecommendRunner(mockFetchClient, mockRecommender, stderrBuffer, stdoutBuffer, mockProfile.Output, mockProfile.Prompt, nil, nil, testMessageSenderFactory, testRecommendCacheFactory, testFeedSelectorFactory, healthMonitor, nil)
+			require.NoError(t, runErr)
+
+			if tt.expectFetch {
+				article := entity.Article{Title: "Article", Link: "https://ok.example.com/1"}
+				mockFetchClient.EXPECT().Fetch(gomock.Any(), entity.Feed{URL: "https://ok.example.com/feed"}).Return([]entity.Article{article}, nil)
+				mockRecommender.EXPECT().Recommend(gomock.Any(), gomock.Any()).Return(&entity.Recommend{Article: article}, nil)
+			}
+
+			err := runner.Run(context.Background(), &RecommendParams{Feeds: tt.feeds}, mockProfile)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Contains(t, stderrBuffer.String(), "すべてのフィードが連続した取得失敗により一時的に除外されています")
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Contains(t, stderrBuffer.String(), "連続して取得に失敗しているためスキップします: https://broken.example.com/feed")
+		})
+	}
+}
+
+func TestRecommendRunner_Run_Filters(t *testing.T) {
+	sponsored := entity.Article{Title: "[PR] Sponsored post", Link: "https://example.com/pr"}
+	regular := entity.Article{Title: "Go generics deep dive", Link: "https://example.com/go"}
+
+	tests := []struct {
+		name            string
+		articles        []entity.Article
+		expectedStdout  string
+		expectRecommend bool
+	}{
+		{
+			name:            "除外した記事を推薦候補に含めない",
+			articles:        []entity.Article{sponsored, regular},
+			expectRecommend: true,
+		},
+		{
+			name:           "すべての記事が除外された場合",
+			articles:       []entity.Article{sponsored},
+			expectedStdout: "フィルタ条件に一致する新しい記事が見つかりませんでした。",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockFetchClient := mock_domain.NewMockFetchClient(ctrl)
+			mockRecommender := mock_domain.NewMockRecommender(ctrl)
+
+			stderrBuffer := new(bytes.Buffer)
+			stdoutBuffer := new(bytes.Buffer)
+			mockProfile := createMockConfig(&entity.PromptConfig{CommentPromptTemplate: "test-prompt-template"}, &entity.OutputConfig{})
+			mockProfile.Filters = &entity.FilterConfig{ExcludeKeywords: []string{"sponsored"}}
+
+			runner, runErr := NewRecommendRunner(mockFetchClient, mockRecommender, stderrBuffer, stdoutBuffer, mockProfile.Output, mockProfile.Prompt, nil, nil, testMessageSenderFactory, testRecommendCacheFactory, testFeedSelectorFactory, nil, nil)
+			require.NoError(t, runErr)
+
+			mockFetchClient.EXPECT().Fetch(gomock.Any(), gomock.Any()).Return(tt.articles, nil)
+			if tt.expectRecommend {
+				mockRecommender.EXPECT().Recommend(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, articles []entity.Article) (*entity.Recommend, error) {
+						require.Len(t, articles, 1)
+						assert.Equal(t, regular.Link, articles[0].Link)
+						return &entity.Recommend{Article: articles[0]}, nil
+					})
+			}
+
+			params := &RecommendParams{Feeds: entity.NewFeedsFromURLs([]string{"https://example.com/feed"})}
+			err := runner.Run(context.Background(), params, mockProfile)
+
+			require.NoError(t, err)
+			assert.Contains(t, stderrBuffer.String(), "フィルタ条件により1件の記事を除外しました")
+			assert.Contains(t, stdoutBuffer.String(), tt.expectedStdout)
+		})
+	}
+}
+
 func TestRecommendRunner_Run_AllOutputsDisabled(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -419,8 +613,12 @@ func TestRecommendRunner_Run_AllOutputsDisabled(t *testing.T) {
 		outputConfig,
 		&entity.PromptConfig{},
 		nil, // cacheConfig
+		nil, // fetchConfig
 		testMessageSenderFactory,
 		testRecommendCacheFactory,
+		testFeedSelectorFactory,
+		nil,
+		nil,
 	)
 
 	assert.NoError(t, err)
@@ -436,11 +634,15 @@ func TestRecommendRunner_Run_AllOutputsDisabled(t *testing.T) {
 	}
 
 	// モックの期待値をセットアップ
-	mockFetchClient.EXPECT().Fetch(gomock.Any()).Return(testArticles, nil)
-	mockRecommender.EXPECT().Recommend(gomock.Any(), testArticles).Return(testRecommend, nil)
+	mockFetchClient.EXPECT().Fetch(gomock.Any(), gomock.Any()).Return(testArticles, nil)
+	// 取得した記事には取得元フィードの情報が設定される
+	wantArticles := []entity.Article{
+		{Title: "Test Article", Link: "https://example.com/test", FeedURL: "https://example.com/feed", FeedName: "https://example.com/feed"},
+	}
+	mockRecommender.EXPECT().Recommend(gomock.Any(), wantArticles).Return(testRecommend, nil)
 
 	// テストを実行 - エラーにならないことを確認
-	params := &RecommendParams{URLs: []string{"https://example.com/feed"}}
+	params := &RecommendParams{Feeds: entity.NewFeedsFromURLs([]string{"https://example.com/feed"})}
 	profile := &entity.Profile{}
 	err = runner.Run(context.Background(), params, profile)
 
@@ -464,7 +666,7 @@ func TestRecommendRunner_Run_ConfigLogging(t *testing.T) {
 	mockRecommender := mock_domain.NewMockRecommender(ctrl)
 
 	// モックの期待値を設定
-	mockFetchClient.EXPECT().Fetch(gomock.Any()).Return([]entity.Article{{Title: "Test Article", Link: "http://example.com/test"}}, nil).AnyTimes()
+	mockFetchClient.EXPECT().Fetch(gomock.Any(), gomock.Any()).Return([]entity.Article{{Title: "Test Article", Link: "http://example.com/test"}}, nil).AnyTimes()
 	mockRecommender.EXPECT().Recommend(gomock.Any(), gomock.Any()).Return(&entity.Recommend{Article: entity.Article{Title: "Test Article", Link: "http://example.com/test"}}, nil).AnyTimes()
 
 	stderrBuffer := new(bytes.Buffer)
@@ -481,7 +683,7 @@ func TestRecommendRunner_Run_ConfigLogging(t *testing.T) {
 	}
 
 	// NewRecommendRunner の引数として渡す
-	runner, err := NewRecommendRunner(mockFetchClient, mockRecommender, stderrBuffer, stdoutBuffer, testOutputConfig, testPromptConfig, testCacheConfig, testMessageSenderFactory, testRecommendCacheFactory)
+	runner, err := NewRecommendRunner(mockFetchClient, mockRecommender, stderrBuffer, stdoutBuffer, testOutputConfig, testPromptConfig, testCacheConfig, nil, testMessageSenderFactory, testRecommendCacheFactory, testFeedSelectorFactory, nil, nil)
 	require.NoError(t, err)
 	require.NotNil(t, runner)
 
@@ -491,7 +693,7 @@ func TestRecommendRunner_Run_ConfigLogging(t *testing.T) {
 	mockSender.EXPECT().ServiceName().Return("MockService").AnyTimes()
 	runner.senders = []domain.MessageSender{mockSender}
 
-	params := &RecommendParams{URLs: []string{"http://example.com/feed"}}
+	params := &RecommendParams{Feeds: entity.NewFeedsFromURLs([]string{"http://example.com/feed"})}
 
 	// Run メソッドを実行
 	err = runner.Run(context.Background(), params, testProfile)
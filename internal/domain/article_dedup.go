@@ -0,0 +1,235 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+)
+
+// titleShingleSize はタイトルの類似度の計算に使う文字n-gramの長さ
+const titleShingleSize = 3
+
+// DuplicateGroup は同じ記事とみなしてまとめた記事のグループを表す
+type DuplicateGroup struct {
+	Kept       entity.Article   // 推薦候補として残した記事
+	Duplicates []entity.Article // 重複として取り除いた記事
+}
+
+// ArticleDeduplicator は複数のフィードから取得した記事のうち、同じ記事を1件にまとめる
+// 正規化したURL・GUIDが一致する記事と、別のフィードのタイトルが似ている記事を同じ記事とみなす
+type ArticleDeduplicator struct {
+	normalizer      *URLNormalizer
+	titleSimilarity float64
+	keep            string
+}
+
+// NewArticleDeduplicator はArticleDeduplicatorを作成する
+// configがnilの場合はデフォルトの類似度と残し方を使う
+func NewArticleDeduplicator(config *entity.DedupConfig, normalizer *URLNormalizer) *ArticleDeduplicator {
+	return &ArticleDeduplicator{
+		normalizer:      normalizer,
+		titleSimilarity: config.TitleSimilarityOrDefault(),
+		keep:            config.KeepOrDefault(),
+	}
+}
+
+// Apply は重複する記事をまとめた記事一覧と、まとめた記事のグループを返す
+// 記事の並び順は各グループの最初の記事の位置を保ち、残した記事には同じ記事を配信していたフィードを記録する
+func (d *ArticleDeduplicator) Apply(articles []entity.Article) ([]entity.Article, []DuplicateGroup) {
+	groups := newArticleUnion(len(articles))
+
+	// URLとGUIDが一致する記事をまとめる
+	firstByKey := make(map[string]int)
+	for i := range articles {
+		for _, key := range d.identityKeys(articles[i]) {
+			if j, ok := firstByKey[key]; ok {
+				groups.union(j, i)
+			} else {
+				firstByKey[key] = i
+			}
+		}
+	}
+
+	// 別のフィードでタイトルが似ている記事をまとめる
+	shingles := make([]map[string]bool, len(articles))
+	for i := range articles {
+		shingles[i] = titleShingles(articles[i].Title)
+	}
+	for i := range articles {
+		for j := i + 1; j < len(articles); j++ {
+			if articles[i].FeedURL == articles[j].FeedURL || groups.find(i) == groups.find(j) {
+				continue
+			}
+			if jaccard(shingles[i], shingles[j]) >= d.titleSimilarity {
+				groups.union(i, j)
+			}
+		}
+	}
+
+	members := make(map[int][]int)
+	var roots []int
+	for i := range articles {
+		root := groups.find(i)
+		if _, ok := members[root]; !ok {
+			roots = append(roots, root)
+		}
+		members[root] = append(members[root], i)
+	}
+
+	result := make([]entity.Article, 0, len(roots))
+	var duplicates []DuplicateGroup
+	for _, root := range roots {
+		indexes := members[root]
+		if len(indexes) == 1 {
+			result = append(result, articles[indexes[0]])
+			continue
+		}
+
+		keptIndex := d.chooseKept(articles, indexes)
+		kept := articles[keptIndex]
+		group := DuplicateGroup{}
+		for _, i := range indexes {
+			if feedURL := articles[i].FeedURL; feedURL != "" && !slices.Contains(kept.SourceFeedURLs, feedURL) {
+				kept.SourceFeedURLs = append(slices.Clip(kept.SourceFeedURLs), feedURL)
+			}
+			if i != keptIndex {
+				group.Duplicates = append(group.Duplicates, articles[i])
+			}
+		}
+		group.Kept = kept
+		result = append(result, kept)
+		duplicates = append(duplicates, group)
+	}
+	return result, duplicates
+}
+
+// identityKeys は記事を識別するキー（正規化したURLとGUID）を返す
+// URL形式でないGUIDはフィードごとに独自の値の場合があるため、同じフィード内でのみ比較する
+func (d *ArticleDeduplicator) identityKeys(article entity.Article) []string {
+	var keys []string
+	for _, link := range []string{article.Link, article.OriginalLink} {
+		if link != "" {
+			keys = append(keys, "url:"+d.normalizer.Key(link))
+		}
+	}
+
+	guid := strings.TrimSpace(article.GUID)
+	switch {
+	case guid == "":
+	case strings.HasPrefix(guid, "http://") || strings.HasPrefix(guid, "https://"):
+		keys = append(keys, "url:"+d.normalizer.Key(guid))
+	case strings.HasPrefix(guid, "urn:") || strings.HasPrefix(guid, "tag:"):
+		keys = append(keys, "guid:"+guid)
+	default:
+		keys = append(keys, "guid:"+article.FeedURL+"\x00"+guid)
+	}
+	return keys
+}
+
+// chooseKept はグループの中から残す記事の位置を返す
+func (d *ArticleDeduplicator) chooseKept(articles []entity.Article, indexes []int) int {
+	kept := indexes[0]
+	for _, i := range indexes[1:] {
+		if d.isPreferred(articles[i], articles[kept]) {
+			kept = i
+		}
+	}
+	return kept
+}
+
+// isPreferred はcandidateをcurrentより優先して残すかどうかを返す（同じ条件の場合は先に取得した記事を残す）
+func (d *ArticleDeduplicator) isPreferred(candidate, current entity.Article) bool {
+	if d.keep == entity.DedupKeepEarliest {
+		switch {
+		case candidate.Published == nil:
+			return false
+		case current.Published == nil:
+			return true
+		default:
+			return candidate.Published.Before(*current.Published)
+		}
+	}
+	return utf8.RuneCountInString(strings.TrimSpace(candidate.Content)) > utf8.RuneCountInString(strings.TrimSpace(current.Content))
+}
+
+// titleShingles はタイトルを正規化し、文字n-gramの集合を返す
+// 大文字・小文字、空白、記号の違いは無視する
+func titleShingles(title string) map[string]bool {
+	runes := normalizedRunes(title)
+
+	shingles := make(map[string]bool)
+	if len(runes) == 0 {
+		return shingles
+	}
+	if len(runes) < titleShingleSize {
+		shingles[string(runes)] = true
+		return shingles
+	}
+	for i := 0; i+titleShingleSize <= len(runes); i++ {
+		shingles[string(runes[i:i+titleShingleSize])] = true
+	}
+	return shingles
+}
+
+// normalizedRunes は文字と数字のみを小文字にして返す
+func normalizedRunes(text string) []rune {
+	var runes []rune
+	for _, r := range strings.ToLower(text) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			runes = append(runes, r)
+		}
+	}
+	return runes
+}
+
+// jaccard は2つの集合のJaccard係数を返す（どちらかが空の場合は0）
+func jaccard(a, b map[string]bool) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	intersection := 0
+	for shingle := range a {
+		if b[shingle] {
+			intersection++
+		}
+	}
+	return float64(intersection) / float64(len(a)+len(b)-intersection)
+}
+
+// articleUnion は記事のグループを管理するUnion-Find
+type articleUnion struct {
+	parent []int
+}
+
+func newArticleUnion(size int) *articleUnion {
+	parent := make([]int, size)
+	for i := range parent {
+		parent[i] = i
+	}
+	return &articleUnion{parent: parent}
+}
+
+// find は記事が属するグループの代表を返す
+func (u *articleUnion) find(i int) int {
+	for u.parent[i] != i {
+		u.parent[i] = u.parent[u.parent[i]]
+		i = u.parent[i]
+	}
+	return i
+}
+
+// union は2つの記事のグループをまとめる（先に現れた記事を代表にする）
+func (u *articleUnion) union(i, j int) {
+	rootI, rootJ := u.find(i), u.find(j)
+	if rootI == rootJ {
+		return
+	}
+	if rootI < rootJ {
+		u.parent[rootJ] = rootI
+	} else {
+		u.parent[rootI] = rootJ
+	}
+}
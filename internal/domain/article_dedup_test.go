@@ -0,0 +1,106 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArticleDeduplicator_Apply(t *testing.T) {
+	const (
+		feedA = "https://a.example.com/feed"
+		feedB = "https://b.example.com/feed"
+	)
+	older := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		config          *entity.DedupConfig
+		articles        []entity.Article
+		wantLinks       []string
+		wantSourceFeeds [][]string
+	}{
+		{
+			name: "正規化したURLが一致する記事をまとめる",
+			articles: []entity.Article{
+				{Title: "記事1", Link: "https://example.com/1", FeedURL: feedA},
+				{Title: "記事2", Link: "https://example.com/2", FeedURL: feedA},
+				{Title: "Article 1", Link: "http://www.example.com/1/", FeedURL: feedB, Content: "詳しい本文"},
+			},
+			wantLinks:       []string{"http://www.example.com/1/", "https://example.com/2"},
+			wantSourceFeeds: [][]string{{feedA, feedB}, nil},
+		},
+		{
+			name: "URL形式のGUIDが一致する記事をまとめる",
+			articles: []entity.Article{
+				{Title: "記事1", Link: "https://aggregator.example.com/r/1", GUID: "https://example.com/1", FeedURL: feedA},
+				{Title: "Article 1", Link: "https://example.com/1?utm_source=rss", FeedURL: feedB},
+			},
+			wantLinks:       []string{"https://aggregator.example.com/r/1"},
+			wantSourceFeeds: [][]string{{feedA, feedB}},
+		},
+		{
+			name: "URL形式でないGUIDは別のフィードでは比較しない",
+			articles: []entity.Article{
+				{Title: "記事1", Link: "https://a.example.com/1", GUID: "1", FeedURL: feedA},
+				{Title: "記事2", Link: "https://b.example.com/2", GUID: "1", FeedURL: feedB},
+			},
+			wantLinks:       []string{"https://a.example.com/1", "https://b.example.com/2"},
+			wantSourceFeeds: [][]string{nil, nil},
+		},
+		{
+			name: "別のフィードでタイトルが似ている記事をまとめる",
+			articles: []entity.Article{
+				{Title: "Go 1.25 is released!", Link: "https://a.example.com/go125", FeedURL: feedA, Content: "短い"},
+				{Title: "Go 1.25 is Released", Link: "https://b.example.com/posts/99", FeedURL: feedB, Content: "こちらの方が長い本文"},
+				{Title: "Go 1.24 is released", Link: "https://a.example.com/go124", FeedURL: feedA},
+			},
+			wantLinks:       []string{"https://b.example.com/posts/99", "https://a.example.com/go124"},
+			wantSourceFeeds: [][]string{{feedA, feedB}, nil},
+		},
+		{
+			name: "同じフィード内ではタイトルが似ていてもまとめない",
+			articles: []entity.Article{
+				{Title: "Weekly news #10", Link: "https://a.example.com/10", FeedURL: feedA},
+				{Title: "Weekly news #10", Link: "https://a.example.com/10-2", FeedURL: feedA},
+			},
+			wantLinks:       []string{"https://a.example.com/10", "https://a.example.com/10-2"},
+			wantSourceFeeds: [][]string{nil, nil},
+		},
+		{
+			name:   "earliestの場合は公開日時が古い記事を残す",
+			config: &entity.DedupConfig{Keep: entity.DedupKeepEarliest},
+			articles: []entity.Article{
+				{Title: "Same story", Link: "https://a.example.com/1", FeedURL: feedA, Published: &newer, Content: "長い本文です"},
+				{Title: "Same story", Link: "https://b.example.com/1", FeedURL: feedB, Published: &older},
+			},
+			wantLinks:       []string{"https://b.example.com/1"},
+			wantSourceFeeds: [][]string{{feedA, feedB}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deduplicator := NewArticleDeduplicator(tt.config, NewURLNormalizer(nil))
+
+			result, groups := deduplicator.Apply(tt.articles)
+
+			require.Len(t, result, len(tt.wantLinks))
+			for i, article := range result {
+				assert.Equal(t, tt.wantLinks[i], article.Link)
+				assert.Equal(t, tt.wantSourceFeeds[i], article.SourceFeedURLs)
+			}
+			assert.Len(t, groups, len(tt.articles)-len(tt.wantLinks))
+		})
+	}
+}
+
+func TestJaccard(t *testing.T) {
+	assert.Equal(t, 1.0, jaccard(titleShingles("Hello, World"), titleShingles("hello world")))
+	assert.Equal(t, 0.0, jaccard(titleShingles("abc"), titleShingles("")))
+	assert.Less(t, jaccard(titleShingles("Go 1.25 is released"), titleShingles("Go 1.24 is released")), entity.DefaultDedupTitleSimilarity)
+}
@@ -0,0 +1,209 @@
+package domain
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+)
+
+// DroppedArticle はフィルタで除外された記事と、除外した理由を表す
+type DroppedArticle struct {
+	Article entity.Article
+	Reason  string
+}
+
+// ArticleFilter はプロファイルのフィルタ設定に従って推薦候補の記事を絞り込む
+type ArticleFilter struct {
+	config          entity.FilterConfig
+	fields          []string
+	includePatterns []*regexp.Regexp
+	excludePatterns []*regexp.Regexp
+	fullText        *fullTextPolicy
+	now             func() time.Time
+}
+
+// NewArticleFilter はArticleFilterを作成する
+// configがnilの場合はすべての記事を残すフィルタを返す
+func NewArticleFilter(config *entity.FilterConfig) (*ArticleFilter, error) {
+	filter := &ArticleFilter{
+		fields: config.FieldsOrDefault(),
+		now:    time.Now,
+	}
+	if config == nil {
+		return filter, nil
+	}
+	filter.config = *config
+
+	var err error
+	if filter.includePatterns, err = compilePatterns(config.IncludePatterns); err != nil {
+		return nil, err
+	}
+	if filter.excludePatterns, err = compilePatterns(config.ExcludePatterns); err != nil {
+		return nil, err
+	}
+	return filter, nil
+}
+
+// WithFullText は記事ページから本文を取得する設定をフィルタに反映する
+// 本文を取得する対象の記事は、フィードの本文が短くてもmin_content_lengthで除外しない
+func (f *ArticleFilter) WithFullText(config *entity.FullTextConfig, feeds []entity.Feed) *ArticleFilter {
+	policy := newFullTextPolicy(config, feeds)
+	f.fullText = &policy
+	return f
+}
+
+// compilePatterns は正規表現の一覧をコンパイルする
+func compilePatterns(patterns []string) ([]*regexp.Regexp, error) {
+	compiled := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid filter pattern %q: %w", pattern, err)
+		}
+		compiled = append(compiled, re)
+	}
+	return compiled, nil
+}
+
+// Apply は記事を絞り込み、残った記事と除外した記事を返す
+func (f *ArticleFilter) Apply(articles []entity.Article) (kept []entity.Article, dropped []DroppedArticle) {
+	for _, article := range articles {
+		if reason, ok := f.Check(article); !ok {
+			dropped = append(dropped, DroppedArticle{Article: article, Reason: reason})
+			continue
+		}
+		kept = append(kept, article)
+	}
+	return kept, dropped
+}
+
+// Check は記事がフィルタの条件を満たすかどうかを判定する
+// 条件を満たさない場合は、除外した理由を返す
+func (f *ArticleFilter) Check(article entity.Article) (reason string, ok bool) {
+	if blockedDomain, blocked := f.blockedDomain(article.Link); blocked {
+		return fmt.Sprintf("blocked domain %q", blockedDomain), false
+	}
+
+	if f.config.MaxAge > 0 && article.Published != nil {
+		if age := f.now().Sub(*article.Published); age > f.config.MaxAge {
+			return fmt.Sprintf("older than %s (published %s)", f.config.MaxAge, article.Published.Format(time.RFC3339)), false
+		}
+	}
+
+	// 本文はHTMLのことがあるため、文字数とキーワード・正規表現はプレーンテキストに変換してから判定する
+	content := NormalizeContent(article.Content)
+
+	if f.config.MinContentLength > 0 && (f.fullText == nil || !f.fullText.needsFullText(&article)) {
+		if length := utf8.RuneCountInString(content); length < f.config.MinContentLength {
+			return fmt.Sprintf("content too short (%d < %d characters)", length, f.config.MinContentLength), false
+		}
+	}
+
+	if category, denied := findCategory(article.Categories, f.config.DenyCategories); denied {
+		return fmt.Sprintf("denied category %q", category), false
+	}
+	if len(f.config.AllowCategories) > 0 {
+		if _, allowed := findCategory(article.Categories, f.config.AllowCategories); !allowed {
+			return "no allowed category", false
+		}
+	}
+
+	texts := f.targetTexts(article, content)
+	if keyword, found := findKeyword(texts, f.config.ExcludeKeywords); found {
+		return fmt.Sprintf("excluded keyword %q", keyword), false
+	}
+	if re, found := findPattern(texts, f.excludePatterns); found {
+		return fmt.Sprintf("excluded pattern %q", re.String()), false
+	}
+	if len(f.config.IncludeKeywords) > 0 || len(f.includePatterns) > 0 {
+		_, keywordFound := findKeyword(texts, f.config.IncludeKeywords)
+		_, patternFound := findPattern(texts, f.includePatterns)
+		if !keywordFound && !patternFound {
+			return "no include keyword or pattern matched", false
+		}
+	}
+
+	return "", true
+}
+
+// targetTexts はキーワード・正規表現の照合対象の文字列を返す
+// 本文はプレーンテキストに変換済みのcontentを使う
+func (f *ArticleFilter) targetTexts(article entity.Article, content string) []string {
+	texts := make([]string, 0, len(f.fields))
+	for _, field := range f.fields {
+		switch field {
+		case entity.FilterFieldTitle:
+			texts = append(texts, article.Title)
+		case entity.FilterFieldContent:
+			texts = append(texts, content)
+		case entity.FilterFieldURL:
+			texts = append(texts, article.Link)
+		}
+	}
+	return texts
+}
+
+// blockedDomain は記事のURLのホストがブロック対象のドメイン（またはそのサブドメイン）かどうかを判定する
+func (f *ArticleFilter) blockedDomain(link string) (string, bool) {
+	if len(f.config.BlockedDomains) == 0 {
+		return "", false
+	}
+	parsed, err := url.Parse(link)
+	if err != nil {
+		return "", false
+	}
+	host := strings.ToLower(parsed.Hostname())
+	for _, blocked := range f.config.BlockedDomains {
+		blocked = strings.ToLower(strings.TrimSpace(blocked))
+		if blocked != "" && (host == blocked || strings.HasSuffix(host, "."+blocked)) {
+			return blocked, true
+		}
+	}
+	return "", false
+}
+
+// findCategory は記事のカテゴリのうち、候補に含まれるもの（大文字小文字を区別しない）を返す
+func findCategory(categories []string, candidates []string) (string, bool) {
+	for _, category := range categories {
+		if slices.ContainsFunc(candidates, func(candidate string) bool {
+			return strings.EqualFold(strings.TrimSpace(category), strings.TrimSpace(candidate))
+		}) {
+			return category, true
+		}
+	}
+	return "", false
+}
+
+// findKeyword はいずれかの文字列に含まれるキーワード（大文字小文字を区別しない）を返す
+func findKeyword(texts []string, keywords []string) (string, bool) {
+	for _, keyword := range keywords {
+		lowerKeyword := strings.ToLower(keyword)
+		if lowerKeyword == "" {
+			continue
+		}
+		for _, text := range texts {
+			if strings.Contains(strings.ToLower(text), lowerKeyword) {
+				return keyword, true
+			}
+		}
+	}
+	return "", false
+}
+
+// findPattern はいずれかの文字列に一致する正規表現を返す
+func findPattern(texts []string, patterns []*regexp.Regexp) (*regexp.Regexp, bool) {
+	for _, re := range patterns {
+		for _, text := range texts {
+			if re.MatchString(text) {
+				return re, true
+			}
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,208 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"github.com/canpok1/ai-feed/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArticleFilter_Check(t *testing.T) {
+	now := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	recent := now.Add(-24 * time.Hour)
+	old := now.Add(-10 * 24 * time.Hour)
+
+	baseArticle := entity.Article{
+		Title:      "Go 1.22 released",
+		Link:       "https://blog.example.com/go-1-22",
+		Published:  &recent,
+		Content:    "The Go team is happy to announce the release of Go 1.22.",
+		Categories: []string{"Go", "Release"},
+	}
+	withArticle := func(modify func(a *entity.Article)) entity.Article {
+		article := baseArticle
+		modify(&article)
+		return article
+	}
+
+	tests := []struct {
+		name       string
+		config     *entity.FilterConfig
+		article    entity.Article
+		wantOK     bool
+		wantReason string
+	}{
+		{
+			name:    "設定なしの場合はすべて残す",
+			config:  nil,
+			article: baseArticle,
+			wantOK:  true,
+		},
+		{
+			name:       "除外キーワードは大文字小文字を区別しない",
+			config:     &entity.FilterConfig{ExcludeKeywords: []string{"sponsored"}},
+			article:    withArticle(func(a *entity.Article) { a.Title = "[Sponsored] Try our product" }),
+			wantReason: `excluded keyword "sponsored"`,
+		},
+		{
+			name:       "除外キーワードは照合対象のフィールドのみ確認する",
+			config:     &entity.FilterConfig{ExcludeKeywords: []string{"announce"}, Fields: []string{entity.FilterFieldTitle}},
+			article:    baseArticle,
+			wantOK:     true,
+			wantReason: "",
+		},
+		{
+			name:       "URLに対する除外パターン",
+			config:     &entity.FilterConfig{ExcludePatterns: []string{`/jobs?/`}},
+			article:    withArticle(func(a *entity.Article) { a.Link = "https://example.com/jobs/123" }),
+			wantReason: `excluded pattern "/jobs?/"`,
+		},
+		{
+			name:    "包含キーワードに一致する記事を残す",
+			config:  &entity.FilterConfig{IncludeKeywords: []string{"rust", "go 1.22"}},
+			article: baseArticle,
+			wantOK:  true,
+		},
+		{
+			name:    "包含パターンに一致する記事を残す",
+			config:  &entity.FilterConfig{IncludeKeywords: []string{"rust"}, IncludePatterns: []string{`Go \d+\.\d+`}},
+			article: baseArticle,
+			wantOK:  true,
+		},
+		{
+			name:       "包含条件に一致しない記事を除外する",
+			config:     &entity.FilterConfig{IncludeKeywords: []string{"rust"}},
+			article:    baseArticle,
+			wantReason: "no include keyword or pattern matched",
+		},
+		{
+			name:       "拒否カテゴリ",
+			config:     &entity.FilterConfig{DenyCategories: []string{"release"}},
+			article:    baseArticle,
+			wantReason: `denied category "Release"`,
+		},
+		{
+			name:    "許可カテゴリ",
+			config:  &entity.FilterConfig{AllowCategories: []string{"go"}},
+			article: baseArticle,
+			wantOK:  true,
+		},
+		{
+			name:       "許可カテゴリを持たない記事を除外する",
+			config:     &entity.FilterConfig{AllowCategories: []string{"python"}},
+			article:    baseArticle,
+			wantReason: "no allowed category",
+		},
+		{
+			name:       "ブロック対象のドメインのサブドメイン",
+			config:     &entity.FilterConfig{BlockedDomains: []string{"example.com"}},
+			article:    baseArticle,
+			wantReason: `blocked domain "example.com"`,
+		},
+		{
+			name:    "ドメイン名の一部が一致するだけでは除外しない",
+			config:  &entity.FilterConfig{BlockedDomains: []string{"ample.com"}},
+			article: baseArticle,
+			wantOK:  true,
+		},
+		{
+			name:       "本文が短い記事を除外する",
+			config:     &entity.FilterConfig{MinContentLength: 10},
+			article:    withArticle(func(a *entity.Article) { a.Content = "  短い本文  " }),
+			wantReason: "content too short (4 < 10 characters)",
+		},
+		{
+			name:   "本文の文字数はHTMLのタグを除いて数える",
+			config: &entity.FilterConfig{MinContentLength: 10},
+			article: withArticle(func(a *entity.Article) {
+				a.Content = `<div class="entry"><p><a href="https://example.com/very/long/link">短い</a>本文</p><script>trackPageView("article")</script></div>`
+			}),
+			wantReason: "content too short (4 < 10 characters)",
+		},
+		{
+			name:   "キーワードはHTMLのタグや属性に一致しない",
+			config: &entity.FilterConfig{ExcludeKeywords: []string{"sponsored"}, Fields: []string{entity.FilterFieldContent}},
+			article: withArticle(func(a *entity.Article) {
+				a.Content = `<p class="sponsored-banner"><img src="/img/sponsored.png" alt="">Go 1.22 is out.</p>`
+			}),
+			wantOK: true,
+		},
+		{
+			name:   "キーワードは文字参照をデコードした本文と照合する",
+			config: &entity.FilterConfig{ExcludeKeywords: []string{"Q&A"}, Fields: []string{entity.FilterFieldContent}},
+			article: withArticle(func(a *entity.Article) {
+				a.Content = `<p>Weekly <strong>Q&amp;A</strong> session</p>`
+			}),
+			wantReason: `excluded keyword "Q&A"`,
+		},
+		{
+			name:       "古い記事を除外する",
+			config:     &entity.FilterConfig{MaxAge: 72 * time.Hour},
+			article:    withArticle(func(a *entity.Article) { a.Published = &old }),
+			wantReason: "older than 72h0m0s (published 2023-12-31T00:00:00Z)",
+		},
+		{
+			name:    "公開日時のない記事は経過時間で除外しない",
+			config:  &entity.FilterConfig{MaxAge: 72 * time.Hour},
+			article: withArticle(func(a *entity.Article) { a.Published = nil }),
+			wantOK:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := NewArticleFilter(tt.config)
+			require.NoError(t, err)
+			filter.now = func() time.Time { return now }
+
+			reason, ok := filter.Check(tt.article)
+
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantReason, reason)
+		})
+	}
+}
+
+func TestArticleFilter_WithFullText(t *testing.T) {
+	feeds := []entity.Feed{
+		{URL: "https://example.com/summary.xml", FullText: testutil.BoolPtr(true)},
+		{URL: "https://example.com/other.xml"},
+	}
+	summary := entity.Article{Title: "要約のみ", Link: "https://example.com/1", Content: "要約", FeedURL: feeds[0].URL}
+	other := entity.Article{Title: "別のフィード", Link: "https://example.com/2", Content: "要約", FeedURL: feeds[1].URL}
+
+	filter, err := NewArticleFilter(&entity.FilterConfig{MinContentLength: 10})
+	require.NoError(t, err)
+	filter = filter.WithFullText(nil, feeds)
+
+	_, ok := filter.Check(summary)
+	assert.True(t, ok, "本文を取得する記事は文字数で除外しない")
+	reason, ok := filter.Check(other)
+	assert.False(t, ok, "本文を取得しない記事は文字数で除外する")
+	assert.Equal(t, "content too short (2 < 10 characters)", reason)
+}
+
+func TestArticleFilter_Apply(t *testing.T) {
+	filter, err := NewArticleFilter(&entity.FilterConfig{ExcludeKeywords: []string{"job"}})
+	require.NoError(t, err)
+
+	articles := []entity.Article{
+		{Title: "Hiring: Go engineer job", Link: "https://example.com/1"},
+		{Title: "Go generics deep dive", Link: "https://example.com/2"},
+	}
+
+	kept, dropped := filter.Apply(articles)
+
+	assert.Equal(t, []entity.Article{articles[1]}, kept)
+	require.Len(t, dropped, 1)
+	assert.Equal(t, articles[0], dropped[0].Article)
+	assert.Equal(t, `excluded keyword "job"`, dropped[0].Reason)
+}
+
+func TestNewArticleFilter_InvalidPattern(t *testing.T) {
+	_, err := NewArticleFilter(&entity.FilterConfig{ExcludePatterns: []string{"("}})
+	assert.ErrorContains(t, err, `invalid filter pattern "("`)
+}
@@ -3,13 +3,28 @@ package domain
 import (
 	"errors"
 	"time"
+
+	"github.com/canpok1/ai-feed/internal/domain/entity"
 )
 
 // RecommendEntry represents a single cache entry for recommended articles
 type RecommendEntry struct {
-	URL      string    `json:"url"`
-	Title    string    `json:"title"`
-	PostedAt time.Time `json:"posted_at"`
+	URL string `json:"url"`
+	// OriginalURL is the URL listed in the feed when it differs from the canonical URL
+	OriginalURL string     `json:"original_url,omitempty"`
+	Title       string     `json:"title"`
+	PostedAt    time.Time  `json:"posted_at"`
+	FeedURL     string     `json:"feed_url,omitempty"`
+	FeedTitle   string     `json:"feed_title,omitempty"`
+	GUID        string     `json:"guid,omitempty"`
+	Author      string     `json:"author,omitempty"`
+	Categories  []string   `json:"categories,omitempty"`
+	ImageURL    string     `json:"image_url,omitempty"`
+	Published   *time.Time `json:"published,omitempty"`
+	// SourceFeedURLs lists the feeds that carried the same article in the run
+	SourceFeedURLs []string `json:"source_feed_urls,omitempty"`
+	// Fingerprint is the simhash of the title and content used to detect articles on the same topic (0 if unknown)
+	Fingerprint uint64 `json:"fingerprint,omitempty,string"`
 }
 
 // RecommendCache provides an interface for managing recommend article cache
@@ -20,8 +35,15 @@ type RecommendCache interface {
 	// IsCached checks if the given URL is already cached (duplicate check)
 	IsCached(url string) bool
 
-	// AddEntry adds a new entry to the cache with the given URL and title
-	AddEntry(url, title string) error
+	// AddEntry adds the given article to the cache
+	AddEntry(article entity.Article) error
+
+	// LastRecommendedAt returns when an article from the given feed URL was last recommended
+	// (false if no article from the feed is in the cache)
+	LastRecommendedAt(feedURL string) (time.Time, bool)
+
+	// RecentEntries returns the entries posted at or after the given time
+	RecentEntries(since time.Time) []RecommendEntry
 
 	// Close closes the cache, releases locks and performs cleanup
 	Close() error
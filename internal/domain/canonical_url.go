@@ -0,0 +1,243 @@
+package domain
+
+import (
+	"context"
+	"log/slog"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+)
+
+// DefaultTrackingParams はURLの正規化で常に取り除くトラッキング用のクエリパラメータ（末尾の*で前方一致）
+var DefaultTrackingParams = []string{
+	"utm_*",
+	"fbclid",
+	"gclid",
+	"dclid",
+	"gbraid",
+	"wbraid",
+	"msclkid",
+	"yclid",
+	"twclid",
+	"igshid",
+	"mc_cid",
+	"mc_eid",
+	"_hsenc",
+	"_hsmi",
+	"mkt_tok",
+	"ref_src",
+}
+
+// ampCacheHostSuffix はGoogle AMP CacheのURLのホスト名の接尾辞
+const ampCacheHostSuffix = ".cdn.ampproject.org"
+
+// URLNormalizer はネットワークにアクセスせずに記事URLを正規化する
+// スキームとホスト名の小文字化、デフォルトポート・フラグメント・トラッキング用パラメータの除去、AMP版URLの通常版への変換を行う
+// AMP版のURLはGoogle AMP CacheのURLとAMP用のパラメータのみ変換し、パスとホスト名は設定で有効にした場合のみ変換する
+type URLNormalizer struct {
+	stripParams     []string
+	rewriteAMPPaths bool
+}
+
+// NewURLNormalizer はURLNormalizerを作成する
+// configがnilの場合はDefaultTrackingParamsのみを取り除く
+func NewURLNormalizer(config *entity.CanonicalURLConfig) *URLNormalizer {
+	stripParams := slices.Clone(DefaultTrackingParams)
+	if config != nil {
+		for _, param := range config.StripParams {
+			stripParams = append(stripParams, strings.ToLower(strings.TrimSpace(param)))
+		}
+	}
+	return &URLNormalizer{stripParams: stripParams, rewriteAMPPaths: config.IsRewriteAMPPathsEnabled()}
+}
+
+// Normalize は記事URLを正規化したURLを返す
+// http(s)以外のURLや解析できないURLは前後の空白を取り除いてそのまま返す
+func (n *URLNormalizer) Normalize(rawURL string) string {
+	trimmed := strings.TrimSpace(rawURL)
+	u, err := url.Parse(trimmed)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return trimmed
+	}
+
+	u = unwrapAMPCacheURL(u)
+	u.Host = strings.ToLower(u.Host)
+	if port := u.Port(); (u.Scheme == "http" && port == "80") || (u.Scheme == "https" && port == "443") {
+		u.Host = u.Hostname()
+	}
+	u.Fragment = ""
+	u.RawFragment = ""
+	if n.rewriteAMPPaths {
+		// /tags/ampやamp.devのようにAMP版ではないURLもあるため、設定で有効にした場合のみ変換する
+		if host, ok := strings.CutPrefix(u.Host, "amp."); ok && strings.Contains(host, ".") {
+			u.Host = host
+		}
+		u.Path = stripAMPPath(u.Path)
+		u.RawPath = ""
+	}
+	u.RawQuery = n.normalizeQuery(u.Query())
+
+	return u.String()
+}
+
+// Key は重複チェックに使う比較用のURLを返す
+// Normalizeに加えて、http/httpsの違い、www.の有無、末尾のスラッシュの有無を同一視する
+func (n *URLNormalizer) Key(rawURL string) string {
+	normalized := n.Normalize(rawURL)
+	u, err := url.Parse(normalized)
+	if err != nil || u.Host == "" {
+		return strings.TrimSuffix(normalized, "/")
+	}
+
+	u.Scheme = "https"
+	u.Host = strings.TrimPrefix(u.Host, "www.")
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	return u.String()
+}
+
+// NormalizeArticles は記事のURLを正規化する
+// 正規化でURLが変わった記事は、元のURLをOriginalLinkに保持する
+func (n *URLNormalizer) NormalizeArticles(articles []entity.Article) []entity.Article {
+	normalized := make([]entity.Article, len(articles))
+	for i, article := range articles {
+		normalized[i] = withCanonicalLink(article, n.Normalize(article.Link))
+	}
+	return normalized
+}
+
+// normalizeQuery はトラッキング用パラメータとAMP用パラメータを取り除き、キーの順に並べたクエリ文字列を返す
+func (n *URLNormalizer) normalizeQuery(query url.Values) string {
+	for key, values := range query {
+		if n.isStripParam(key) || isAMPParam(key, values) {
+			delete(query, key)
+		}
+	}
+	return query.Encode()
+}
+
+// isStripParam は取り除く対象のクエリパラメータかどうかを返す
+func (n *URLNormalizer) isStripParam(key string) bool {
+	key = strings.ToLower(key)
+	for _, param := range n.stripParams {
+		if prefix, ok := strings.CutSuffix(param, "*"); ok {
+			if strings.HasPrefix(key, prefix) {
+				return true
+			}
+		} else if key == param {
+			return true
+		}
+	}
+	return false
+}
+
+// isAMPParam はAMP版のページを表すクエリパラメータ（?amp, ?amp=1, ?outputType=amp）かどうかを返す
+func isAMPParam(key string, values []string) bool {
+	switch strings.ToLower(key) {
+	case "amp":
+		return len(values) == 0 || values[0] == "" || values[0] == "1" || strings.EqualFold(values[0], "true")
+	case "outputtype":
+		return len(values) > 0 && strings.EqualFold(values[0], "amp")
+	default:
+		return false
+	}
+}
+
+// stripAMPPath はAMP版のページを表すパス（/amp, /amp/, /amp/..., .amp.html）を通常版のパスに変換する
+func stripAMPPath(path string) string {
+	switch {
+	case strings.HasSuffix(path, "/amp"):
+		return strings.TrimSuffix(path, "amp")
+	case strings.HasSuffix(path, "/amp/"):
+		return strings.TrimSuffix(path, "amp/")
+	case strings.HasPrefix(path, "/amp/"):
+		return strings.TrimPrefix(path, "/amp")
+	case strings.HasSuffix(path, ".amp.html"):
+		return strings.TrimSuffix(path, ".amp.html") + ".html"
+	default:
+		return path
+	}
+}
+
+// unwrapAMPCacheURL はGoogle AMP CacheのURL（https://example-com.cdn.ampproject.org/c/s/example.com/path）を元のURLに戻す
+func unwrapAMPCacheURL(u *url.URL) *url.URL {
+	if !strings.HasSuffix(strings.ToLower(u.Hostname()), ampCacheHostSuffix) {
+		return u
+	}
+
+	// パスは /c/ または /v/ で始まり、HTTPSの場合は続けて /s/ が入る
+	rest, ok := strings.CutPrefix(u.Path, "/c/")
+	if !ok {
+		if rest, ok = strings.CutPrefix(u.Path, "/v/"); !ok {
+			return u
+		}
+	}
+	scheme := "http"
+	if after, ok := strings.CutPrefix(rest, "s/"); ok {
+		scheme = "https"
+		rest = after
+	}
+
+	host, path, _ := strings.Cut(rest, "/")
+	if host == "" {
+		return u
+	}
+	return &url.URL{Scheme: scheme, Host: host, Path: "/" + path, RawQuery: u.RawQuery}
+}
+
+// withCanonicalLink は記事のURLを正規URLに置き換えたコピーを返す
+// 最初に置き換えたときのURLをOriginalLinkに保持する
+func withCanonicalLink(article entity.Article, canonicalLink string) entity.Article {
+	if canonicalLink == article.Link {
+		return article
+	}
+	if article.OriginalLink == "" {
+		article.OriginalLink = article.Link
+	}
+	article.Link = canonicalLink
+	return article
+}
+
+// URLResolver は記事ページにアクセスして正規URLを調べるインターフェース
+type URLResolver interface {
+	// Resolve はリダイレクト先や<link rel="canonical">から記事の正規URLを返す
+	Resolve(ctx context.Context, articleURL string) (string, error)
+}
+
+// CanonicalURLArticleSelector は選択した記事のURLを、記事ページから調べた正規URLに置き換えるArticleSelector
+// 記事ページへアクセスするのは選択された1件のみで、取得に失敗した場合は元のURLのまま返す
+type CanonicalURLArticleSelector struct {
+	selector   ArticleSelector
+	resolver   URLResolver
+	normalizer *URLNormalizer
+}
+
+// NewCanonicalURLArticleSelector はCanonicalURLArticleSelectorを作成する
+func NewCanonicalURLArticleSelector(selector ArticleSelector, resolver URLResolver, normalizer *URLNormalizer) *CanonicalURLArticleSelector {
+	return &CanonicalURLArticleSelector{
+		selector:   selector,
+		resolver:   resolver,
+		normalizer: normalizer,
+	}
+}
+
+// Select は記事を選択し、URLを正規URLに置き換える
+func (s *CanonicalURLArticleSelector) Select(ctx context.Context, articles []entity.Article) (*entity.Article, error) {
+	article, err := s.selector.Select(ctx, articles)
+	if err != nil || article == nil || article.Link == "" {
+		return article, err
+	}
+
+	resolved, err := s.resolver.Resolve(ctx, article.Link)
+	if err != nil {
+		slog.Warn("Failed to resolve canonical URL, using article link", "url", article.Link, "error", err)
+		return article, nil
+	}
+
+	canonical := withCanonicalLink(*article, s.normalizer.Normalize(resolved))
+	if canonical.Link != article.Link {
+		slog.Info("Replaced article link with canonical URL", "url", article.Link, "canonical_url", canonical.Link)
+	}
+	return &canonical, nil
+}
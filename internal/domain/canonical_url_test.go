@@ -0,0 +1,135 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"github.com/canpok1/ai-feed/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// resolverStub は、テスト用の正規URLを返すURLResolverの実装
+type resolverStub struct {
+	url string
+	err error
+}
+
+func (r *resolverStub) Resolve(ctx context.Context, articleURL string) (string, error) {
+	return r.url, r.err
+}
+
+func TestURLNormalizer_Normalize(t *testing.T) {
+	rewriteAMP := &entity.CanonicalURLConfig{RewriteAMPPaths: testutil.BoolPtr(true)}
+
+	tests := []struct {
+		name     string
+		config   *entity.CanonicalURLConfig
+		input    string
+		expected string
+	}{
+		{name: "トラッキング用パラメータを取り除く", input: "https://example.com/post?utm_source=rss&utm_medium=feed&id=1&fbclid=abc", expected: "https://example.com/post?id=1"},
+		{name: "クエリパラメータをキーの順に並べる", input: "https://example.com/post?b=2&a=1", expected: "https://example.com/post?a=1&b=2"},
+		{name: "スキームとホスト名を小文字にする", input: "HTTPS://Example.COM/Post", expected: "https://example.com/Post"},
+		{name: "デフォルトポートを取り除く", input: "https://example.com:443/post", expected: "https://example.com/post"},
+		{name: "フラグメントを取り除く", input: "https://example.com/post#comments", expected: "https://example.com/post"},
+		{name: "AMP版のパスを通常版にする", config: rewriteAMP, input: "https://example.com/news/123/amp/", expected: "https://example.com/news/123/"},
+		{name: "AMP版のパラメータを取り除く", input: "https://example.com/news/123?amp=1", expected: "https://example.com/news/123"},
+		{name: "AMP版のサブドメインを取り除く", config: rewriteAMP, input: "https://amp.example.com/news/123", expected: "https://example.com/news/123"},
+		{name: "設定がなければ/ampで終わるパスは変換しない", input: "https://example.com/tags/amp", expected: "https://example.com/tags/amp"},
+		{name: "設定がなければamp.で始まるホスト名は変換しない", input: "https://amp.dev/documentation/", expected: "https://amp.dev/documentation/"},
+		{name: "設定がなければAMP版のサブドメインは変換しない", input: "https://amp.example.com/news/123", expected: "https://amp.example.com/news/123"},
+		{name: "Google AMP CacheのURLを元のURLに戻す", input: "https://example-com.cdn.ampproject.org/c/s/example.com/news/123", expected: "https://example.com/news/123"},
+		{name: "設定したパラメータを取り除く", config: &entity.CanonicalURLConfig{StripParams: []string{"ref", "share_*"}}, input: "https://example.com/post?ref=top&share_id=1&page=2", expected: "https://example.com/post?page=2"},
+		{name: "http(s)以外のURLはそのまま返す", input: " mailto:user@example.com ", expected: "mailto:user@example.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NewURLNormalizer(tt.config).Normalize(tt.input))
+		})
+	}
+}
+
+func TestURLNormalizer_Key(t *testing.T) {
+	normalizer := NewURLNormalizer(nil)
+	expected := normalizer.Key("https://example.com/post")
+
+	for _, input := range []string{
+		"http://example.com/post",
+		"https://www.example.com/post",
+		"https://example.com/post/",
+		"https://example.com/post?utm_source=rss",
+		"https://example.com/post#top",
+	} {
+		assert.Equal(t, expected, normalizer.Key(input), input)
+	}
+	assert.NotEqual(t, expected, normalizer.Key("https://example.com/post?id=2"))
+}
+
+func TestURLNormalizer_NormalizeArticles(t *testing.T) {
+	articles := []entity.Article{
+		{Link: "https://example.com/1?utm_source=rss"},
+		{Link: "https://example.com/2"},
+	}
+
+	normalized := NewURLNormalizer(nil).NormalizeArticles(articles)
+
+	assert.Equal(t, "https://example.com/1", normalized[0].Link)
+	assert.Equal(t, "https://example.com/1?utm_source=rss", normalized[0].OriginalLink)
+	assert.Equal(t, "https://example.com/2", normalized[1].Link)
+	assert.Empty(t, normalized[1].OriginalLink)
+	// 元の記事は変更しない
+	assert.Equal(t, "https://example.com/1?utm_source=rss", articles[0].Link)
+}
+
+func TestCanonicalURLArticleSelector_Select(t *testing.T) {
+	tests := []struct {
+		name             string
+		link             string
+		originalLink     string
+		resolver         *resolverStub
+		wantLink         string
+		wantOriginalLink string
+	}{
+		{
+			name:             "正規URLに置き換える",
+			link:             "https://feedproxy.example.com/~r/blog/1",
+			resolver:         &resolverStub{url: "https://blog.example.com/posts/1?utm_source=feedburner"},
+			wantLink:         "https://blog.example.com/posts/1",
+			wantOriginalLink: "https://feedproxy.example.com/~r/blog/1",
+		},
+		{
+			name:             "正規化済みの記事は最初のURLを保持する",
+			link:             "https://feedproxy.example.com/~r/blog/1",
+			originalLink:     "https://feedproxy.example.com/~r/blog/1?utm_source=rss",
+			resolver:         &resolverStub{url: "https://blog.example.com/posts/1"},
+			wantLink:         "https://blog.example.com/posts/1",
+			wantOriginalLink: "https://feedproxy.example.com/~r/blog/1?utm_source=rss",
+		},
+		{
+			name:     "取得に失敗した場合は元のURLを使う",
+			link:     "https://example.com/1",
+			resolver: &resolverStub{err: errors.New("timeout")},
+			wantLink: "https://example.com/1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inner := &mockArticleSelector{
+				selectFunc: func(ctx context.Context, articles []entity.Article) (*entity.Article, error) {
+					return &articles[0], nil
+				},
+			}
+			selector := NewCanonicalURLArticleSelector(inner, tt.resolver, NewURLNormalizer(nil))
+
+			selected, err := selector.Select(context.Background(), []entity.Article{{Link: tt.link, OriginalLink: tt.originalLink}})
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantLink, selected.Link)
+			assert.Equal(t, tt.wantOriginalLink, selected.OriginalLink)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/net/html"
+)
+
+// skippedTags は中身をテキストとして扱わない要素
+var skippedTags = map[string]bool{
+	"script":   true,
+	"style":    true,
+	"noscript": true,
+	"template": true,
+	"iframe":   true,
+	"svg":      true,
+	"head":     true,
+}
+
+// blockTags は前後で改行するブロック要素
+var blockTags = map[string]bool{
+	"address": true, "article": true, "aside": true, "blockquote": true, "br": true,
+	"dd": true, "div": true, "dl": true, "dt": true, "figcaption": true, "figure": true,
+	"footer": true, "h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"header": true, "hr": true, "li": true, "main": true, "nav": true, "ol": true,
+	"p": true, "pre": true, "section": true, "table": true, "td": true, "th": true,
+	"tr": true, "ul": true,
+}
+
+// NormalizeContent はHTMLの本文をプレーンテキストに変換する
+// 記事のフィルタ・話題の指紋・AIに渡す本文で同じテキストを使うように、本文の変換はこの関数に統一する
+// タグとscript・styleなどの中身を取り除き、文字参照をデコードし、空白をまとめる
+func NormalizeContent(raw string) string {
+	var sb strings.Builder
+	tokenizer := html.NewTokenizer(strings.NewReader(raw))
+	skipDepth := 0
+	for {
+		tokenType := tokenizer.Next()
+		switch tokenType {
+		case html.ErrorToken:
+			return collapseWhitespace(sb.String())
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, _ := tokenizer.TagName()
+			tag := string(name)
+			if skippedTags[tag] && tokenType == html.StartTagToken {
+				skipDepth++
+			}
+			if blockTags[tag] {
+				sb.WriteByte('\n')
+			}
+		case html.EndTagToken:
+			name, _ := tokenizer.TagName()
+			tag := string(name)
+			if skippedTags[tag] && skipDepth > 0 {
+				skipDepth--
+			}
+			if blockTags[tag] {
+				sb.WriteByte('\n')
+			}
+		case html.TextToken:
+			if skipDepth == 0 {
+				sb.Write(tokenizer.Text())
+			}
+		}
+	}
+}
+
+// collapseWhitespace は行内の連続する空白を1つにまとめ、空行を取り除く
+func collapseWhitespace(text string) string {
+	var lines []string
+	for line := range strings.Lines(text) {
+		if line = strings.Join(strings.FieldsFunc(line, unicode.IsSpace), " "); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
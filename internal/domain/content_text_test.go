@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeContent(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      string
+		expected string
+	}{
+		{
+			name:     "プレーンテキストはそのまま",
+			raw:      "Go 1.25 がリリースされました",
+			expected: "Go 1.25 がリリースされました",
+		},
+		{
+			name:     "タグを取り除きブロック要素で改行する",
+			raw:      "<h1>Title</h1><p>First <b>bold</b> paragraph.</p><ul><li>one</li><li>two</li></ul>",
+			expected: "Title\nFirst bold paragraph.\none\ntwo",
+		},
+		{
+			name:     "scriptとstyleの中身を取り除く",
+			raw:      `<style>p { color: red; }</style><p>Body</p><script>alert("x")</script>`,
+			expected: "Body",
+		},
+		{
+			name:     "文字参照をデコードする",
+			raw:      "<p>Tom &amp; Jerry &lt;3 &quot;cheese&quot;&nbsp;&#x1F600;</p>",
+			expected: "Tom & Jerry <3 \"cheese\" 😀",
+		},
+		{
+			name:     "連続する空白と空行をまとめる",
+			raw:      "  line   one\n\n\n\tline two  <br/><br/>  ",
+			expected: "line one\nline two",
+		},
+		{
+			name:     "空文字",
+			raw:      "",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NormalizeContent(tt.raw))
+		})
+	}
+}
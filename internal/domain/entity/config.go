@@ -4,9 +4,15 @@ import (
 	"bytes"
 	"fmt"
 	"log/slog"
+	"net/url"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"text/template"
+	"time"
 )
 
 // テンプレートキャッシュ（スレッドセーフ）
@@ -26,9 +32,21 @@ func IsValidMockSelectorMode(mode string) bool {
 	return ValidMockSelectorModes[mode]
 }
 
+// ValidAIChainProviders はAIの呼び出し順（chain）に指定できるプロバイダ一覧
+var ValidAIChainProviders = map[string]bool{
+	"gemini": true,
+	"openai": true,
+	"ollama": true,
+	"mock":   true,
+}
+
 type AIConfig struct {
 	Gemini *GeminiConfig
+	OpenAI *OpenAIConfig // OpenAI互換のChat Completions APIの設定（設定されている場合はGeminiより優先する）
+	Ollama *OllamaConfig // OllamaのネイティブAPIの設定（設定されている場合はGeminiより優先する）
+	Retry  *AIRetryConfig
 	Mock   *MockConfig
+	Chain  []string // プロバイダを試す順序（設定されている場合は失敗したときに次のプロバイダを使う）
 }
 
 // MockConfig はAIのモック設定を保持する
@@ -80,12 +98,35 @@ func (m MockConfig) LogValue() slog.Value {
 func (a *AIConfig) Validate() *ValidationResult {
 	builder := NewValidationBuilder()
 
+	// Retry: 任意項目
+	if a.Retry != nil {
+		builder.MergeResult(a.Retry.Validate())
+	}
+
 	// Mock設定が有効な場合は、Gemini設定は不要
 	if a.Mock != nil && a.Mock.Enabled != nil && *a.Mock.Enabled {
 		builder.MergeResult(a.Mock.Validate())
 		return builder.Build()
 	}
 
+	// 呼び出し順が設定されている場合は、使用できないプロバイダを実行時に飛ばすため個別の設定は検証しない
+	if len(a.Chain) > 0 {
+		builder.MergeResult(a.ValidateChain())
+		return builder.Build()
+	}
+
+	// OpenAI互換APIの設定がある場合は、Gemini設定は不要
+	if a.OpenAI != nil {
+		builder.MergeResult(a.OpenAI.Validate())
+		return builder.Build()
+	}
+
+	// Ollamaの設定がある場合は、Gemini設定は不要
+	if a.Ollama != nil {
+		builder.MergeResult(a.Ollama.Validate())
+		return builder.Build()
+	}
+
 	// Gemini: 必須項目（nilでない）
 	if a.Gemini == nil {
 		builder.AddError("Gemini設定が設定されていません")
@@ -97,13 +138,63 @@ func (a *AIConfig) Validate() *ValidationResult {
 	return builder.Build()
 }
 
+// ValidateChain はAIの呼び出し順（chain）をバリデーションする
+func (a *AIConfig) ValidateChain() *ValidationResult {
+	builder := NewValidationBuilder()
+
+	valid := true
+	seen := make(map[string]bool, len(a.Chain))
+	for _, name := range a.Chain {
+		if !ValidAIChainProviders[name] {
+			builder.AddError(fmt.Sprintf("AIの呼び出し順に指定したプロバイダが不正です。gemini, openai, ollama, mockのいずれかを指定してください: %s", name))
+			valid = false
+			continue
+		}
+		if seen[name] {
+			builder.AddError(fmt.Sprintf("AIの呼び出し順に同じプロバイダが重複しています: %s", name))
+			valid = false
+		}
+		seen[name] = true
+	}
+	if !valid {
+		return builder.Build()
+	}
+
+	if !slices.ContainsFunc(a.Chain, a.IsChainProviderUsable) {
+		builder.AddError("AIの呼び出し順に使用できるプロバイダがありません。いずれかのプロバイダを設定するか、mockを追加してください")
+	}
+
+	return builder.Build()
+}
+
+// IsChainProviderUsable は呼び出し順のプロバイダの設定が揃っていて使用できるかどうかを返す
+// mockは設定がなくても常に使用できる
+func (a *AIConfig) IsChainProviderUsable(name string) bool {
+	switch name {
+	case "mock":
+		return a.Mock == nil || a.Mock.SelectorMode == "" || IsValidMockSelectorMode(a.Mock.SelectorMode)
+	case "gemini":
+		return a.Gemini != nil && a.Gemini.Validate().IsValid
+	case "openai":
+		return a.OpenAI != nil && a.OpenAI.Validate().IsValid
+	case "ollama":
+		return a.Ollama != nil && a.Ollama.Validate().IsValid
+	default:
+		return false
+	}
+}
+
 // Merge は他のAIConfigの非nil フィールドで現在のAIConfigをマージする
 func (a *AIConfig) Merge(other *AIConfig) {
 	if other == nil {
 		return
 	}
 	mergePtr(&a.Gemini, other.Gemini)
+	mergePtr(&a.OpenAI, other.OpenAI)
+	mergePtr(&a.Ollama, other.Ollama)
+	mergePtr(&a.Retry, other.Retry)
 	mergePtr(&a.Mock, other.Mock)
+	mergeSlice(&a.Chain, other.Chain)
 }
 
 // LogValue はslog出力時に機密情報をマスクするためのメソッド
@@ -112,15 +203,28 @@ func (a AIConfig) LogValue() slog.Value {
 	if a.Gemini != nil {
 		attrs = append(attrs, slog.Any("Gemini", *a.Gemini))
 	}
+	if a.OpenAI != nil {
+		attrs = append(attrs, slog.Any("OpenAI", *a.OpenAI))
+	}
+	if a.Ollama != nil {
+		attrs = append(attrs, slog.Any("Ollama", *a.Ollama))
+	}
+	if a.Retry != nil {
+		attrs = append(attrs, slog.Any("Retry", *a.Retry))
+	}
 	if a.Mock != nil {
 		attrs = append(attrs, slog.Any("Mock", *a.Mock))
 	}
+	if len(a.Chain) > 0 {
+		attrs = append(attrs, slog.Any("Chain", a.Chain))
+	}
 	return slog.GroupValue(attrs...)
 }
 
 type GeminiConfig struct {
-	Type   string
-	APIKey SecretString
+	Type           string
+	APIKey         SecretString
+	FallbackModels []string // Typeのモデルで失敗した場合に順に試すモデル
 }
 
 // Validate はGeminiConfigの内容をバリデーションする
@@ -149,6 +253,7 @@ func (g *GeminiConfig) Merge(other *GeminiConfig) {
 	if !other.APIKey.IsEmpty() {
 		g.APIKey = other.APIKey
 	}
+	mergeSlice(&g.FallbackModels, other.FallbackModels)
 }
 
 // LogValue はslog出力時に機密情報をマスクするためのメソッド
@@ -156,6 +261,233 @@ func (g GeminiConfig) LogValue() slog.Value {
 	return slog.GroupValue(
 		slog.String("Type", g.Type),
 		slog.Any("APIKey", g.APIKey),
+		slog.Any("FallbackModels", g.FallbackModels),
+	)
+}
+
+// DefaultOpenAIBaseURL はOpenAI互換APIのベースURLのデフォルト値
+const DefaultOpenAIBaseURL = "https://api.openai.com/v1"
+
+// OpenAIConfig はOpenAI互換のChat Completions API（/v1/chat/completions）の設定を保持する
+// Ollama、llama.cppのサーバー、vLLMなどのローカルモデルにも使える
+type OpenAIConfig struct {
+	BaseURL        string       // APIのベースURL（例: http://localhost:11434/v1、空の場合はDefaultOpenAIBaseURL）
+	Model          string       // モデル名
+	APIKey         SecretString // APIキー（認証が不要なローカルのサーバーの場合は空でよい）
+	JSONMode       *bool        // 記事選択でJSON形式の応答（response_format）を要求するか（nilの場合は要求する）
+	FallbackModels []string     // Modelのモデルで失敗した場合に順に試すモデル
+}
+
+// BaseURLOrDefault はAPIのベースURLを返す（未設定の場合はDefaultOpenAIBaseURL）
+func (o *OpenAIConfig) BaseURLOrDefault() string {
+	if o == nil || o.BaseURL == "" {
+		return DefaultOpenAIBaseURL
+	}
+	return strings.TrimRight(o.BaseURL, "/")
+}
+
+// IsJSONModeEnabled は記事選択でJSON形式の応答を要求するかどうかを返す（未設定の場合は要求する）
+func (o *OpenAIConfig) IsJSONModeEnabled() bool {
+	return o == nil || o.JSONMode == nil || *o.JSONMode
+}
+
+// Validate はOpenAIConfigの内容をバリデーションする
+func (o *OpenAIConfig) Validate() *ValidationResult {
+	builder := NewValidationBuilder()
+
+	// Model: 必須項目（空文字列でない）
+	if err := ValidateRequired(o.Model, "OpenAI設定のモデル名"); err != nil {
+		builder.AddError(err.Error())
+	}
+
+	// BaseURL: 任意項目、http(s)のURLであること（localhost:11434 のようなスキームの書き忘れも検出する）
+	if o.BaseURL != "" && !isHTTPBaseURL(o.BaseURL) {
+		builder.AddError(fmt.Sprintf("OpenAI設定のベースURLにはhttp://またはhttps://で始まるURLを指定してください: %s", o.BaseURL))
+	}
+
+	return builder.Build()
+}
+
+// Merge は他のOpenAIConfigの非空フィールドで現在のOpenAIConfigをマージする
+func (o *OpenAIConfig) Merge(other *OpenAIConfig) {
+	if other == nil {
+		return
+	}
+	mergeString(&o.BaseURL, other.BaseURL)
+	mergeString(&o.Model, other.Model)
+	if !other.APIKey.IsEmpty() {
+		o.APIKey = other.APIKey
+	}
+	if other.JSONMode != nil {
+		o.JSONMode = other.JSONMode
+	}
+	mergeSlice(&o.FallbackModels, other.FallbackModels)
+}
+
+// LogValue はslog出力時に機密情報をマスクするためのメソッド
+func (o OpenAIConfig) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("BaseURL", o.BaseURLOrDefault()),
+		slog.String("Model", o.Model),
+		slog.Any("APIKey", o.APIKey),
+		slog.Bool("JSONMode", o.IsJSONModeEnabled()),
+		slog.Any("FallbackModels", o.FallbackModels),
+	)
+}
+
+const (
+	// DefaultAIRetryMaxAttempts はAI呼び出しの1モデルあたりの最大試行回数のデフォルト値
+	DefaultAIRetryMaxAttempts = 3
+	// DefaultAIRetryInitialInterval はAI呼び出しの最初のリトライまでの待ち時間のデフォルト値
+	DefaultAIRetryInitialInterval = 2 * time.Second
+	// DefaultAIRetryMaxInterval はAI呼び出しのリトライの待ち時間の上限のデフォルト値
+	DefaultAIRetryMaxInterval = 30 * time.Second
+)
+
+// AIRetryConfig は一時的なエラー（429、503など）で失敗したAI呼び出しのリトライの設定を保持する
+// 待ち時間はリトライのたびに倍になり（ジッターあり）、APIが待ち時間を指定した場合はそれに従う
+type AIRetryConfig struct {
+	MaxAttempts     int           // 1モデルあたりの最大試行回数（0の場合はDefaultAIRetryMaxAttempts、1の場合はリトライしない）
+	InitialInterval time.Duration // 最初のリトライまでの待ち時間（0の場合はDefaultAIRetryInitialInterval）
+	MaxInterval     time.Duration // リトライの待ち時間の上限（0の場合はDefaultAIRetryMaxInterval）
+}
+
+// MaxAttemptsOrDefault は1モデルあたりの最大試行回数を返す（未設定の場合はDefaultAIRetryMaxAttempts）
+func (r *AIRetryConfig) MaxAttemptsOrDefault() int {
+	if r == nil || r.MaxAttempts <= 0 {
+		return DefaultAIRetryMaxAttempts
+	}
+	return r.MaxAttempts
+}
+
+// InitialIntervalOrDefault は最初のリトライまでの待ち時間を返す（未設定の場合はDefaultAIRetryInitialInterval）
+func (r *AIRetryConfig) InitialIntervalOrDefault() time.Duration {
+	if r == nil || r.InitialInterval <= 0 {
+		return DefaultAIRetryInitialInterval
+	}
+	return r.InitialInterval
+}
+
+// MaxIntervalOrDefault はリトライの待ち時間の上限を返す（未設定の場合はDefaultAIRetryMaxInterval）
+func (r *AIRetryConfig) MaxIntervalOrDefault() time.Duration {
+	if r == nil || r.MaxInterval <= 0 {
+		return DefaultAIRetryMaxInterval
+	}
+	return r.MaxInterval
+}
+
+// Validate はAIRetryConfigの内容をバリデーションする
+func (r *AIRetryConfig) Validate() *ValidationResult {
+	builder := NewValidationBuilder()
+
+	if r.MaxAttempts < 0 {
+		builder.AddError("AI呼び出しの最大試行回数には0以上の値を指定してください")
+	}
+	if r.InitialInterval < 0 || r.MaxInterval < 0 {
+		builder.AddError("AI呼び出しのリトライの待ち時間には0以上の値を指定してください")
+	}
+	if r.InitialIntervalOrDefault() > r.MaxIntervalOrDefault() {
+		builder.AddError(fmt.Sprintf("AI呼び出しの最初のリトライまでの待ち時間（%s）が待ち時間の上限（%s）を超えています",
+			r.InitialIntervalOrDefault(), r.MaxIntervalOrDefault()))
+	}
+
+	return builder.Build()
+}
+
+// Merge は他のAIRetryConfigの非ゼロ値フィールドで現在のAIRetryConfigをマージする
+func (r *AIRetryConfig) Merge(other *AIRetryConfig) {
+	if other == nil {
+		return
+	}
+	if other.MaxAttempts > 0 {
+		r.MaxAttempts = other.MaxAttempts
+	}
+	if other.InitialInterval > 0 {
+		r.InitialInterval = other.InitialInterval
+	}
+	if other.MaxInterval > 0 {
+		r.MaxInterval = other.MaxInterval
+	}
+}
+
+// LogValue はslog出力時に設定値を読みやすく表示するためのメソッド
+func (r AIRetryConfig) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Int("MaxAttempts", r.MaxAttemptsOrDefault()),
+		slog.Duration("InitialInterval", r.InitialIntervalOrDefault()),
+		slog.Duration("MaxInterval", r.MaxIntervalOrDefault()),
+	)
+}
+
+// isHTTPBaseURL はAPIのベースURLとして使えるhttp(s)のURLかどうかを返す
+func isHTTPBaseURL(value string) bool {
+	parsed, err := url.Parse(value)
+	return err == nil && (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
+
+// DefaultOllamaBaseURL はOllamaのベースURLのデフォルト値
+const DefaultOllamaBaseURL = "http://localhost:11434"
+
+// OllamaConfig はOllamaのネイティブAPI（/api/chat、/api/generate）の設定を保持する
+type OllamaConfig struct {
+	BaseURL        string   // OllamaのベースURL（空の場合はDefaultOllamaBaseURL）
+	Model          string   // モデル名（例: llama3.1）
+	KeepAlive      string   // 応答後にモデルをメモリに保持する時間（例: 5m、空の場合はOllamaのデフォルト）
+	FallbackModels []string // Modelのモデルで失敗した場合に順に試すモデル
+}
+
+// BaseURLOrDefault はOllamaのベースURLを返す（未設定の場合はDefaultOllamaBaseURL）
+func (o *OllamaConfig) BaseURLOrDefault() string {
+	if o == nil || o.BaseURL == "" {
+		return DefaultOllamaBaseURL
+	}
+	return strings.TrimRight(o.BaseURL, "/")
+}
+
+// Validate はOllamaConfigの内容をバリデーションする
+func (o *OllamaConfig) Validate() *ValidationResult {
+	builder := NewValidationBuilder()
+
+	// Model: 必須項目（空文字列でない）
+	if err := ValidateRequired(o.Model, "Ollama設定のモデル名"); err != nil {
+		builder.AddError(err.Error())
+	}
+
+	// BaseURL: 任意項目、http(s)のURLであること
+	if o.BaseURL != "" && !isHTTPBaseURL(o.BaseURL) {
+		builder.AddError(fmt.Sprintf("Ollama設定のベースURLにはhttp://またはhttps://で始まるURLを指定してください: %s", o.BaseURL))
+	}
+
+	// KeepAlive: 任意項目、時間の形式（例: 5m、1h）または秒数（-1は無期限）であること
+	if o.KeepAlive != "" {
+		_, durationErr := time.ParseDuration(o.KeepAlive)
+		_, secondsErr := strconv.Atoi(o.KeepAlive)
+		if durationErr != nil && secondsErr != nil {
+			builder.AddError(fmt.Sprintf("Ollama設定のkeep_aliveが不正です（例: 5m、1h、-1）: %s", o.KeepAlive))
+		}
+	}
+
+	return builder.Build()
+}
+
+// Merge は他のOllamaConfigの非空フィールドで現在のOllamaConfigをマージする
+func (o *OllamaConfig) Merge(other *OllamaConfig) {
+	if other == nil {
+		return
+	}
+	mergeString(&o.BaseURL, other.BaseURL)
+	mergeString(&o.Model, other.Model)
+	mergeString(&o.KeepAlive, other.KeepAlive)
+	mergeSlice(&o.FallbackModels, other.FallbackModels)
+}
+
+// LogValue はslog出力時の表示内容を返す
+func (o OllamaConfig) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("BaseURL", o.BaseURLOrDefault()),
+		slog.String("Model", o.Model),
+		slog.String("KeepAlive", o.KeepAlive),
+		slog.Any("FallbackModels", o.FallbackModels),
 	)
 }
 
@@ -164,6 +496,8 @@ type PromptConfig struct {
 	CommentPromptTemplate string
 	SelectorPrompt        string
 	FixedMessage          string
+	SelectorContentBudget ContentBudget // 記事選択プロンプトに含める記事1件あたりの本文の上限
+	CommentContentBudget  ContentBudget // コメントプロンプトに含める記事本文の上限
 }
 
 // Validate はPromptConfigの内容をバリデーションする
@@ -187,6 +521,10 @@ func (p *PromptConfig) Validate() *ValidationResult {
 
 	// FixedMessage: 任意項目（空文字列でも可）
 
+	// ContentBudget: 任意項目だが、設定されている場合は0以上であること
+	builder.MergeResult(p.SelectorContentBudget.Validate("記事選択プロンプト"))
+	builder.MergeResult(p.CommentContentBudget.Validate("コメントプロンプト"))
+
 	return builder.Build()
 }
 
@@ -242,6 +580,20 @@ func (p *PromptConfig) Merge(other *PromptConfig) {
 	mergeString(&p.CommentPromptTemplate, other.CommentPromptTemplate)
 	mergeString(&p.SelectorPrompt, other.SelectorPrompt)
 	mergeString(&p.FixedMessage, other.FixedMessage)
+	p.SelectorContentBudget.Merge(other.SelectorContentBudget)
+	p.CommentContentBudget.Merge(other.CommentContentBudget)
+}
+
+// SelectorContentBudgetOrDefault は記事選択プロンプト用の本文の上限を返す
+// 文字数が未設定の場合はDefaultSelectorContentMaxCharsを使用する
+func (p *PromptConfig) SelectorContentBudgetOrDefault() ContentBudget {
+	return p.SelectorContentBudget.withDefaultMaxChars(DefaultSelectorContentMaxChars)
+}
+
+// CommentContentBudgetOrDefault はコメントプロンプト用の本文の上限を返す
+// 文字数が未設定の場合はDefaultCommentContentMaxCharsを使用する
+func (p *PromptConfig) CommentContentBudgetOrDefault() ContentBudget {
+	return p.CommentContentBudget.withDefaultMaxChars(DefaultCommentContentMaxChars)
 }
 
 // LogValue はslog出力時に設定値を読みやすく表示するためのメソッド
@@ -251,6 +603,62 @@ func (p PromptConfig) LogValue() slog.Value {
 		slog.Int("CommentPromptTemplateLength", len(p.CommentPromptTemplate)),
 		slog.Int("SelectorPromptLength", len(p.SelectorPrompt)),
 		slog.String("FixedMessage", p.FixedMessage),
+		slog.Any("SelectorContentBudget", p.SelectorContentBudget),
+		slog.Any("CommentContentBudget", p.CommentContentBudget),
+	)
+}
+
+// プロンプトに含める記事本文のデフォルトの上限（文字数）
+const (
+	DefaultSelectorContentMaxChars = 500
+	DefaultCommentContentMaxChars  = 4000
+)
+
+// ContentBudget はプロンプトに含める記事本文の長さの上限を表す
+// 両方を指定した場合は、先に上限に達した方で切り詰める
+type ContentBudget struct {
+	MaxChars  int // 最大文字数（0の場合はデフォルト値）
+	MaxTokens int // 最大トークン数の目安（0の場合は制限なし）
+}
+
+// Validate はContentBudgetの内容をバリデーションする
+// targetはエラーメッセージに含めるプロンプトの名前
+func (b ContentBudget) Validate(target string) *ValidationResult {
+	builder := NewValidationBuilder()
+
+	if b.MaxChars < 0 {
+		builder.AddError(fmt.Sprintf("%sに含める本文の最大文字数には0以上の値を指定してください", target))
+	}
+	if b.MaxTokens < 0 {
+		builder.AddError(fmt.Sprintf("%sに含める本文の最大トークン数には0以上の値を指定してください", target))
+	}
+
+	return builder.Build()
+}
+
+// Merge は他のContentBudgetの非ゼロ値フィールドで現在のContentBudgetをマージする
+func (b *ContentBudget) Merge(other ContentBudget) {
+	if other.MaxChars > 0 {
+		b.MaxChars = other.MaxChars
+	}
+	if other.MaxTokens > 0 {
+		b.MaxTokens = other.MaxTokens
+	}
+}
+
+// withDefaultMaxChars は最大文字数が未設定の場合にデフォルト値を補ったContentBudgetを返す
+func (b ContentBudget) withDefaultMaxChars(defaultMaxChars int) ContentBudget {
+	if b.MaxChars <= 0 {
+		b.MaxChars = defaultMaxChars
+	}
+	return b
+}
+
+// LogValue はslog出力時に設定値を読みやすく表示するためのメソッド
+func (b ContentBudget) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Int("MaxChars", b.MaxChars),
+		slog.Int("MaxTokens", b.MaxTokens),
 	)
 }
 
@@ -445,6 +853,10 @@ type CacheConfig struct {
 	FilePath      string
 	MaxEntries    int
 	RetentionDays int
+	// FeedStateFilePath はフィードごとのETag/Last-Modifiedを保存するファイルのパス
+	FeedStateFilePath string
+	// ArticleCacheDir は記事ページから取得した本文を保存するディレクトリのパス
+	ArticleCacheDir string
 }
 
 // Validate はCacheConfigの内容をバリデーションする
@@ -477,6 +889,8 @@ func (c *CacheConfig) Merge(other *CacheConfig) {
 	if other.RetentionDays > 0 {
 		c.RetentionDays = other.RetentionDays
 	}
+	mergeString(&c.FeedStateFilePath, other.FeedStateFilePath)
+	mergeString(&c.ArticleCacheDir, other.ArticleCacheDir)
 }
 
 // LogValue はslog出力時に設定値を読みやすく表示するためのメソッド
@@ -486,13 +900,682 @@ func (c CacheConfig) LogValue() slog.Value {
 		slog.String("FilePath", c.FilePath),
 		slog.Int("MaxEntries", c.MaxEntries),
 		slog.Int("RetentionDays", c.RetentionDays),
+		slog.String("FeedStateFilePath", c.FeedStateFilePath),
+		slog.String("ArticleCacheDir", c.ArticleCacheDir),
+	)
+}
+
+// FetchConfig はフィード取得時のHTTPクライアント設定を保持する
+type FetchConfig struct {
+	UserAgent    string
+	Timeout      time.Duration // 1フィードあたりの取得タイムアウト（0の場合はデフォルト値）
+	Deadline     time.Duration // フィード取得全体のタイムアウト（0の場合はデフォルト値）
+	Concurrency  int           // 同時に取得するフィードの最大数（0の場合はデフォルト値）
+	ProxyURL     string
+	Headers      map[string]string // すべてのリクエストに付与する追加ヘッダー
+	CABundlePath string            // 追加で信頼するCA証明書（PEM形式）のパス
+	MaxBodySize  int64             // レスポンスボディの最大バイト数（0の場合は無制限）
+	AutoDiscover *bool             // フィードとして解析できないWebページからフィードを探すかどうか（nilの場合は探さない）
+}
+
+// IsAutoDiscoverEnabled はフィードの自動検出が有効かどうかを返す（未設定の場合は無効）
+func (f *FetchConfig) IsAutoDiscoverEnabled() bool {
+	return f != nil && f.AutoDiscover != nil && *f.AutoDiscover
+}
+
+// Validate はFetchConfigの内容をバリデーションする
+func (f *FetchConfig) Validate() *ValidationResult {
+	builder := NewValidationBuilder()
+
+	if f.Timeout < 0 {
+		builder.AddError("フィード取得のタイムアウトには0以上の値を指定してください")
+	}
+
+	if f.Deadline < 0 {
+		builder.AddError("フィード取得全体のタイムアウトには0以上の値を指定してください")
+	}
+
+	if f.Concurrency < 0 {
+		builder.AddError("フィードの同時取得数には0以上の値を指定してください")
+	}
+
+	if f.ProxyURL != "" {
+		if err := ValidateURL(f.ProxyURL, "プロキシURL"); err != nil {
+			builder.AddError(err.Error())
+		}
+	}
+
+	if f.MaxBodySize < 0 {
+		builder.AddError("レスポンスボディの最大サイズには0以上の値を指定してください")
+	}
+
+	for name := range f.Headers {
+		if strings.TrimSpace(name) == "" {
+			builder.AddError("フィード取得の追加ヘッダーに空のヘッダー名が含まれています")
+			break
+		}
+	}
+
+	return builder.Build()
+}
+
+// Merge は他のFetchConfigの非ゼロ値フィールドで現在のFetchConfigをマージする
+// Headersはヘッダー名単位でマージする
+func (f *FetchConfig) Merge(other *FetchConfig) {
+	if other == nil {
+		return
+	}
+	mergeString(&f.UserAgent, other.UserAgent)
+	if other.Timeout > 0 {
+		f.Timeout = other.Timeout
+	}
+	if other.Deadline > 0 {
+		f.Deadline = other.Deadline
+	}
+	if other.Concurrency > 0 {
+		f.Concurrency = other.Concurrency
+	}
+	mergeString(&f.ProxyURL, other.ProxyURL)
+	if len(other.Headers) > 0 {
+		headers := make(map[string]string, len(f.Headers)+len(other.Headers))
+		for name, value := range f.Headers {
+			headers[name] = value
+		}
+		for name, value := range other.Headers {
+			headers[name] = value
+		}
+		f.Headers = headers
+	}
+	mergeString(&f.CABundlePath, other.CABundlePath)
+	if other.MaxBodySize > 0 {
+		f.MaxBodySize = other.MaxBodySize
+	}
+	if other.AutoDiscover != nil {
+		f.AutoDiscover = other.AutoDiscover
+	}
+}
+
+// LogValue はslog出力時に設定値を読みやすく表示するためのメソッド
+// ヘッダーの値には認証情報が含まれる可能性があるため、ヘッダー名のみを出力する
+func (f FetchConfig) LogValue() slog.Value {
+	headerNames := make([]string, 0, len(f.Headers))
+	for name := range f.Headers {
+		headerNames = append(headerNames, name)
+	}
+	sort.Strings(headerNames)
+	return slog.GroupValue(
+		slog.String("UserAgent", f.UserAgent),
+		slog.Duration("Timeout", f.Timeout),
+		slog.Duration("Deadline", f.Deadline),
+		slog.Int("Concurrency", f.Concurrency),
+		slog.String("ProxyURL", f.ProxyURL),
+		slog.Any("HeaderNames", headerNames),
+		slog.String("CABundlePath", f.CABundlePath),
+		slog.Int64("MaxBodySize", f.MaxBodySize),
+		slog.Bool("AutoDiscover", f.IsAutoDiscoverEnabled()),
+	)
+}
+
+// フィードの健全性管理のデフォルト値
+const (
+	// DefaultFeedFailureThreshold は取得対象から一時的に外すまでの連続失敗回数のデフォルト値
+	DefaultFeedFailureThreshold = 3
+	// DefaultFeedCooldown は取得対象から一時的に外す期間のデフォルト値
+	DefaultFeedCooldown = 24 * time.Hour
+)
+
+// FeedHealthConfig はフィードの健全性記録と、壊れたフィードの自動除外に関する設定を保持する
+type FeedHealthConfig struct {
+	Enabled          *bool         // 自動除外の有効/無効（nilの場合は有効）
+	FailureThreshold int           // 取得対象から外すまでの連続失敗回数（0の場合はDefaultFeedFailureThreshold）
+	Cooldown         time.Duration // 取得対象から外す期間（0の場合はDefaultFeedCooldown）
+}
+
+// IsEnabled は自動除外が有効かどうかを返す（未設定の場合は有効）
+func (f *FeedHealthConfig) IsEnabled() bool {
+	return f == nil || f.Enabled == nil || *f.Enabled
+}
+
+// FailureThresholdOrDefault は連続失敗回数のしきい値を返す（未設定の場合はDefaultFeedFailureThreshold）
+func (f *FeedHealthConfig) FailureThresholdOrDefault() int {
+	if f == nil || f.FailureThreshold <= 0 {
+		return DefaultFeedFailureThreshold
+	}
+	return f.FailureThreshold
+}
+
+// CooldownOrDefault は取得対象から外す期間を返す（未設定の場合はDefaultFeedCooldown）
+func (f *FeedHealthConfig) CooldownOrDefault() time.Duration {
+	if f == nil || f.Cooldown <= 0 {
+		return DefaultFeedCooldown
+	}
+	return f.Cooldown
+}
+
+// Validate はFeedHealthConfigの内容をバリデーションする
+func (f *FeedHealthConfig) Validate() *ValidationResult {
+	builder := NewValidationBuilder()
+
+	if f.FailureThreshold < 0 {
+		builder.AddError("フィードを除外するまでの連続失敗回数には0以上の値を指定してください")
+	}
+	if f.Cooldown < 0 {
+		builder.AddError("フィードを除外する期間には0以上の値を指定してください")
+	}
+
+	return builder.Build()
+}
+
+// Merge は他のFeedHealthConfigの非ゼロ値フィールドで現在のFeedHealthConfigをマージする
+func (f *FeedHealthConfig) Merge(other *FeedHealthConfig) {
+	if other == nil {
+		return
+	}
+	if other.Enabled != nil {
+		f.Enabled = other.Enabled
+	}
+	if other.FailureThreshold > 0 {
+		f.FailureThreshold = other.FailureThreshold
+	}
+	if other.Cooldown > 0 {
+		f.Cooldown = other.Cooldown
+	}
+}
+
+// LogValue はslog出力時に設定値を読みやすく表示するためのメソッド
+func (f FeedHealthConfig) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Bool("Enabled", f.IsEnabled()),
+		slog.Int("FailureThreshold", f.FailureThreshold),
+		slog.Duration("Cooldown", f.Cooldown),
+	)
+}
+
+// 記事フィルタのキーワード・正規表現の照合対象
+const (
+	FilterFieldTitle   = "title"
+	FilterFieldContent = "content"
+	FilterFieldURL     = "url"
+)
+
+// FilterFields は記事フィルタで指定できる照合対象の一覧
+var FilterFields = []string{FilterFieldTitle, FilterFieldContent, FilterFieldURL}
+
+// FilterConfig は推薦候補の記事を絞り込むルールを保持する
+type FilterConfig struct {
+	IncludeKeywords  []string      // いずれかを含む記事のみ残すキーワード（大文字小文字を区別しない）
+	ExcludeKeywords  []string      // いずれかを含む記事を除外するキーワード（大文字小文字を区別しない）
+	IncludePatterns  []string      // いずれかに一致する記事のみ残す正規表現
+	ExcludePatterns  []string      // いずれかに一致する記事を除外する正規表現
+	Fields           []string      // キーワード・正規表現の照合対象（title, content, url。未指定の場合はすべて）
+	AllowCategories  []string      // いずれかのカテゴリを持つ記事のみ残す
+	DenyCategories   []string      // いずれかのカテゴリを持つ記事を除外する
+	BlockedDomains   []string      // 除外するドメイン（サブドメインを含む）
+	MinContentLength int           // 本文の最小文字数（0の場合は制限なし）
+	MaxAge           time.Duration // 公開日時からの最大経過時間（0の場合は制限なし）
+}
+
+// FieldsOrDefault はキーワード・正規表現の照合対象を返す（未指定の場合はすべて）
+func (f *FilterConfig) FieldsOrDefault() []string {
+	if f == nil || len(f.Fields) == 0 {
+		return FilterFields
+	}
+	return f.Fields
+}
+
+// Validate はFilterConfigの内容をバリデーションする
+func (f *FilterConfig) Validate() *ValidationResult {
+	builder := NewValidationBuilder()
+
+	for _, pattern := range slices.Concat(f.IncludePatterns, f.ExcludePatterns) {
+		if _, err := regexp.Compile(pattern); err != nil {
+			builder.AddError(fmt.Sprintf("記事フィルタの正規表現が不正です: %q", pattern))
+		}
+	}
+	for _, field := range f.Fields {
+		if !slices.Contains(FilterFields, field) {
+			builder.AddError(fmt.Sprintf("記事フィルタの照合対象が不正です: %q（title, content, urlのいずれかを指定してください）", field))
+		}
+	}
+	if f.MinContentLength < 0 {
+		builder.AddError("記事フィルタの本文の最小文字数には0以上の値を指定してください")
+	}
+	if f.MaxAge < 0 {
+		builder.AddError("記事フィルタの最大経過時間には0以上の値を指定してください")
+	}
+
+	return builder.Build()
+}
+
+// Merge は他のFilterConfigの非ゼロ値フィールドで現在のFilterConfigをマージする
+// リストはマージせず、指定されている場合は全体を置き換える
+func (f *FilterConfig) Merge(other *FilterConfig) {
+	if other == nil {
+		return
+	}
+	mergeSlice(&f.IncludeKeywords, other.IncludeKeywords)
+	mergeSlice(&f.ExcludeKeywords, other.ExcludeKeywords)
+	mergeSlice(&f.IncludePatterns, other.IncludePatterns)
+	mergeSlice(&f.ExcludePatterns, other.ExcludePatterns)
+	mergeSlice(&f.Fields, other.Fields)
+	mergeSlice(&f.AllowCategories, other.AllowCategories)
+	mergeSlice(&f.DenyCategories, other.DenyCategories)
+	mergeSlice(&f.BlockedDomains, other.BlockedDomains)
+	if other.MinContentLength > 0 {
+		f.MinContentLength = other.MinContentLength
+	}
+	if other.MaxAge > 0 {
+		f.MaxAge = other.MaxAge
+	}
+}
+
+// LogValue はslog出力時に設定値を読みやすく表示するためのメソッド
+func (f FilterConfig) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Any("IncludeKeywords", f.IncludeKeywords),
+		slog.Any("ExcludeKeywords", f.ExcludeKeywords),
+		slog.Any("IncludePatterns", f.IncludePatterns),
+		slog.Any("ExcludePatterns", f.ExcludePatterns),
+		slog.Any("Fields", f.FieldsOrDefault()),
+		slog.Any("AllowCategories", f.AllowCategories),
+		slog.Any("DenyCategories", f.DenyCategories),
+		slog.Any("BlockedDomains", f.BlockedDomains),
+		slog.Int("MinContentLength", f.MinContentLength),
+		slog.Duration("MaxAge", f.MaxAge),
+	)
+}
+
+// DefaultFullTextMinContentLength は記事ページから本文を取得する本文の文字数のしきい値のデフォルト値
+const DefaultFullTextMinContentLength = 200
+
+// FullTextConfig は本文が短い記事について、記事ページから本文を取得する設定を保持する
+type FullTextConfig struct {
+	Enabled          *bool // 本文取得の有効/無効（nilの場合は無効、フィードごとの設定が優先される）
+	MinContentLength int   // 本文がこの文字数未満の記事のみ取得する（0の場合はDefaultFullTextMinContentLength）
+}
+
+// IsEnabledFor は指定したフィードの記事で本文取得を行うかどうかを返す
+// フィードにfull_textが設定されている場合はその値を、未設定の場合はプロファイルの設定を使用する
+func (f *FullTextConfig) IsEnabledFor(feed Feed) bool {
+	if feed.FullText != nil {
+		return *feed.FullText
+	}
+	return f != nil && f.Enabled != nil && *f.Enabled
+}
+
+// MinContentLengthOrDefault は本文取得を行う本文の文字数のしきい値を返す（未設定の場合はDefaultFullTextMinContentLength）
+func (f *FullTextConfig) MinContentLengthOrDefault() int {
+	if f == nil || f.MinContentLength <= 0 {
+		return DefaultFullTextMinContentLength
+	}
+	return f.MinContentLength
+}
+
+// Validate はFullTextConfigの内容をバリデーションする
+func (f *FullTextConfig) Validate() *ValidationResult {
+	builder := NewValidationBuilder()
+
+	if f.MinContentLength < 0 {
+		builder.AddError("本文を取得する記事の最小文字数には0以上の値を指定してください")
+	}
+
+	return builder.Build()
+}
+
+// Merge は他のFullTextConfigの非ゼロ値フィールドで現在のFullTextConfigをマージする
+func (f *FullTextConfig) Merge(other *FullTextConfig) {
+	if other == nil {
+		return
+	}
+	if other.Enabled != nil {
+		f.Enabled = other.Enabled
+	}
+	if other.MinContentLength > 0 {
+		f.MinContentLength = other.MinContentLength
+	}
+}
+
+// LogValue はslog出力時に設定値を読みやすく表示するためのメソッド
+func (f FullTextConfig) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Bool("Enabled", f.Enabled != nil && *f.Enabled),
+		slog.Int("MinContentLength", f.MinContentLengthOrDefault()),
+	)
+}
+
+// CanonicalURLConfig は記事URLの正規化の設定を保持する
+// 正規化したURLは投稿済み記事の重複チェックと投稿するリンクの両方に使用する
+type CanonicalURLConfig struct {
+	StripParams      []string // デフォルトに加えて取り除くクエリパラメータ（末尾の*で前方一致）
+	FollowRedirects  *bool    // 投稿する記事のリダイレクトをたどるかどうか（nilの場合はたどらない）
+	UseCanonicalLink *bool    // 投稿する記事のページの<link rel="canonical">を使うかどうか（nilの場合は使わない）
+	RewriteAMPPaths  *bool    // /ampで終わるパスやamp.で始まるホスト名を通常版のURLに変換するかどうか（nilの場合は変換しない）
+}
+
+// IsFollowRedirectsEnabled は投稿する記事のリダイレクトをたどるかどうかを返す（未設定の場合は無効）
+func (c *CanonicalURLConfig) IsFollowRedirectsEnabled() bool {
+	return c != nil && c.FollowRedirects != nil && *c.FollowRedirects
+}
+
+// IsCanonicalLinkEnabled は投稿する記事のページの<link rel="canonical">を使うかどうかを返す（未設定の場合は無効）
+func (c *CanonicalURLConfig) IsCanonicalLinkEnabled() bool {
+	return c != nil && c.UseCanonicalLink != nil && *c.UseCanonicalLink
+}
+
+// IsRewriteAMPPathsEnabled はAMP版と思われるパスやホスト名を通常版のURLに変換するかどうかを返す（未設定の場合は無効）
+func (c *CanonicalURLConfig) IsRewriteAMPPathsEnabled() bool {
+	return c != nil && c.RewriteAMPPaths != nil && *c.RewriteAMPPaths
+}
+
+// IsResolveEnabled は記事ページへアクセスして正規URLを調べる必要があるかどうかを返す
+func (c *CanonicalURLConfig) IsResolveEnabled() bool {
+	return c.IsFollowRedirectsEnabled() || c.IsCanonicalLinkEnabled()
+}
+
+// Validate はCanonicalURLConfigの内容をバリデーションする
+func (c *CanonicalURLConfig) Validate() *ValidationResult {
+	builder := NewValidationBuilder()
+
+	for _, param := range c.StripParams {
+		if strings.TrimSpace(strings.TrimSuffix(param, "*")) == "" {
+			builder.AddError(fmt.Sprintf("URLから取り除くクエリパラメータ名が不正です: %q", param))
+		}
+	}
+
+	return builder.Build()
+}
+
+// Merge は他のCanonicalURLConfigの非ゼロ値フィールドで現在のCanonicalURLConfigをマージする
+func (c *CanonicalURLConfig) Merge(other *CanonicalURLConfig) {
+	if other == nil {
+		return
+	}
+	mergeSlice(&c.StripParams, other.StripParams)
+	if other.FollowRedirects != nil {
+		c.FollowRedirects = other.FollowRedirects
+	}
+	if other.UseCanonicalLink != nil {
+		c.UseCanonicalLink = other.UseCanonicalLink
+	}
+	if other.RewriteAMPPaths != nil {
+		c.RewriteAMPPaths = other.RewriteAMPPaths
+	}
+}
+
+// LogValue はslog出力時に設定値を読みやすく表示するためのメソッド
+func (c CanonicalURLConfig) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Any("StripParams", c.StripParams),
+		slog.Bool("FollowRedirects", c.IsFollowRedirectsEnabled()),
+		slog.Bool("UseCanonicalLink", c.IsCanonicalLinkEnabled()),
+		slog.Bool("RewriteAMPPaths", c.IsRewriteAMPPathsEnabled()),
+	)
+}
+
+// 重複記事から残す記事の選び方
+const (
+	// DedupKeepRichest は本文が最も長い記事を残す（デフォルト）
+	DedupKeepRichest = "richest"
+	// DedupKeepEarliest は公開日時が最も古い記事を残す
+	DedupKeepEarliest = "earliest"
+)
+
+// DedupKeepStrategies は指定可能な重複記事の残し方の一覧
+var DedupKeepStrategies = []string{
+	DedupKeepRichest,
+	DedupKeepEarliest,
+}
+
+// DefaultDedupTitleSimilarity は同じ記事とみなすタイトルの類似度のデフォルト値
+const DefaultDedupTitleSimilarity = 0.8
+
+// DedupConfig は複数のフィードから取得した重複記事をまとめる設定を保持する
+type DedupConfig struct {
+	Enabled         *bool   // 重複記事をまとめるかどうか（nilの場合は有効）
+	TitleSimilarity float64 // 別のフィードの記事を同じ記事とみなすタイトルの類似度（0の場合はDefaultDedupTitleSimilarity）
+	Keep            string  // 残す記事の選び方（空の場合はDedupKeepRichest）
+}
+
+// IsEnabled は重複記事をまとめるかどうかを返す（未設定の場合は有効）
+func (d *DedupConfig) IsEnabled() bool {
+	return d == nil || d.Enabled == nil || *d.Enabled
+}
+
+// TitleSimilarityOrDefault はタイトルの類似度のしきい値を返す（未設定の場合はDefaultDedupTitleSimilarity）
+func (d *DedupConfig) TitleSimilarityOrDefault() float64 {
+	if d == nil || d.TitleSimilarity <= 0 {
+		return DefaultDedupTitleSimilarity
+	}
+	return d.TitleSimilarity
+}
+
+// KeepOrDefault は残す記事の選び方を返す（未設定の場合はDedupKeepRichest）
+func (d *DedupConfig) KeepOrDefault() string {
+	if d == nil || d.Keep == "" {
+		return DedupKeepRichest
+	}
+	return d.Keep
+}
+
+// Validate はDedupConfigの内容をバリデーションする
+func (d *DedupConfig) Validate() *ValidationResult {
+	builder := NewValidationBuilder()
+
+	if d.TitleSimilarity < 0 || d.TitleSimilarity > 1 {
+		builder.AddError("重複記事とみなすタイトルの類似度には0から1の値を指定してください")
+	}
+
+	if d.Keep != "" && !slices.Contains(DedupKeepStrategies, d.Keep) {
+		builder.AddError(fmt.Sprintf("重複記事の残し方が不正です: %q（%sのいずれかを指定してください）",
+			d.Keep, strings.Join(DedupKeepStrategies, ", ")))
+	}
+
+	return builder.Build()
+}
+
+// Merge は他のDedupConfigの非ゼロ値フィールドで現在のDedupConfigをマージする
+func (d *DedupConfig) Merge(other *DedupConfig) {
+	if other == nil {
+		return
+	}
+	if other.Enabled != nil {
+		d.Enabled = other.Enabled
+	}
+	if other.TitleSimilarity > 0 {
+		d.TitleSimilarity = other.TitleSimilarity
+	}
+	mergeString(&d.Keep, other.Keep)
+}
+
+// LogValue はslog出力時に設定値を読みやすく表示するためのメソッド
+func (d DedupConfig) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Bool("Enabled", d.IsEnabled()),
+		slog.Float64("TitleSimilarity", d.TitleSimilarityOrDefault()),
+		slog.String("Keep", d.KeepOrDefault()),
+	)
+}
+
+// 過去に推薦した記事と似ている記事の扱い
+const (
+	// TopicSuppressionActionDrop は推薦候補から除外する（デフォルト）
+	TopicSuppressionActionDrop = "drop"
+	// TopicSuppressionActionDemote は推薦候補に残し、他の記事の後ろに回す
+	TopicSuppressionActionDemote = "demote"
+)
+
+// TopicSuppressionActions は指定可能な似ている記事の扱いの一覧
+var TopicSuppressionActions = []string{
+	TopicSuppressionActionDrop,
+	TopicSuppressionActionDemote,
+}
+
+const (
+	// DefaultTopicSimilarity は過去に推薦した記事と同じ話題とみなす類似度のデフォルト値
+	DefaultTopicSimilarity = 0.9
+	// DefaultTopicWindow は比較対象にする推薦履歴の期間のデフォルト値
+	DefaultTopicWindow = 7 * 24 * time.Hour
+)
+
+// TopicSuppressionConfig は過去に推薦した記事と同じ話題の記事を抑制する設定を保持する
+type TopicSuppressionConfig struct {
+	Enabled    *bool         // 抑制の有効/無効（nilの場合は無効）
+	Similarity float64       // 同じ話題とみなす類似度（0の場合はDefaultTopicSimilarity）
+	Window     time.Duration // 比較対象にする推薦履歴の期間（0の場合はDefaultTopicWindow）
+	Action     string        // 似ている記事の扱い（空の場合はTopicSuppressionActionDrop）
+}
+
+// IsEnabled は抑制が有効かどうかを返す（未設定の場合は無効）
+func (t *TopicSuppressionConfig) IsEnabled() bool {
+	return t != nil && t.Enabled != nil && *t.Enabled
+}
+
+// SimilarityOrDefault は同じ話題とみなす類似度を返す（未設定の場合はDefaultTopicSimilarity）
+func (t *TopicSuppressionConfig) SimilarityOrDefault() float64 {
+	if t == nil || t.Similarity <= 0 {
+		return DefaultTopicSimilarity
+	}
+	return t.Similarity
+}
+
+// WindowOrDefault は比較対象にする推薦履歴の期間を返す（未設定の場合はDefaultTopicWindow）
+func (t *TopicSuppressionConfig) WindowOrDefault() time.Duration {
+	if t == nil || t.Window <= 0 {
+		return DefaultTopicWindow
+	}
+	return t.Window
+}
+
+// ActionOrDefault は似ている記事の扱いを返す（未設定の場合はTopicSuppressionActionDrop）
+func (t *TopicSuppressionConfig) ActionOrDefault() string {
+	if t == nil || t.Action == "" {
+		return TopicSuppressionActionDrop
+	}
+	return t.Action
+}
+
+// Validate はTopicSuppressionConfigの内容をバリデーションする
+func (t *TopicSuppressionConfig) Validate() *ValidationResult {
+	builder := NewValidationBuilder()
+
+	if t.Similarity < 0 || t.Similarity > 1 {
+		builder.AddError("同じ話題とみなす類似度には0から1の値を指定してください")
+	}
+	if t.Window < 0 {
+		builder.AddError("同じ話題を抑制する期間には0以上の値を指定してください")
+	}
+	if t.Action != "" && !slices.Contains(TopicSuppressionActions, t.Action) {
+		builder.AddError(fmt.Sprintf("同じ話題の記事の扱いが不正です: %q（%sのいずれかを指定してください）",
+			t.Action, strings.Join(TopicSuppressionActions, ", ")))
+	}
+
+	return builder.Build()
+}
+
+// Merge は他のTopicSuppressionConfigの非ゼロ値フィールドで現在のTopicSuppressionConfigをマージする
+func (t *TopicSuppressionConfig) Merge(other *TopicSuppressionConfig) {
+	if other == nil {
+		return
+	}
+	if other.Enabled != nil {
+		t.Enabled = other.Enabled
+	}
+	if other.Similarity > 0 {
+		t.Similarity = other.Similarity
+	}
+	if other.Window > 0 {
+		t.Window = other.Window
+	}
+	mergeString(&t.Action, other.Action)
+}
+
+// LogValue はslog出力時に設定値を読みやすく表示するためのメソッド
+func (t TopicSuppressionConfig) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Bool("Enabled", t.IsEnabled()),
+		slog.Float64("Similarity", t.SimilarityOrDefault()),
+		slog.Duration("Window", t.WindowOrDefault()),
+		slog.String("Action", t.ActionOrDefault()),
+	)
+}
+
+// フィード選択戦略
+const (
+	// FeedSelectionStrategyRandom は候補のフィードから等確率でランダムに1つを選択する（デフォルト）
+	FeedSelectionStrategyRandom = "random"
+	// FeedSelectionStrategyWeighted はフィードごとの重みに比例した確率で1つを選択する
+	FeedSelectionStrategyWeighted = "weighted"
+	// FeedSelectionStrategyRoundRobin は実行をまたいでフィードを順番に1つずつ選択する
+	FeedSelectionStrategyRoundRobin = "round_robin"
+	// FeedSelectionStrategyLeastRecent は推薦履歴上で最も長く推薦されていないフィードを選択する
+	FeedSelectionStrategyLeastRecent = "least_recent"
+	// FeedSelectionStrategyAll はすべてのフィードを取得して記事をまとめる
+	FeedSelectionStrategyAll = "all"
+)
+
+// FeedSelectionStrategies は指定可能なフィード選択戦略の一覧
+var FeedSelectionStrategies = []string{
+	FeedSelectionStrategyRandom,
+	FeedSelectionStrategyWeighted,
+	FeedSelectionStrategyRoundRobin,
+	FeedSelectionStrategyLeastRecent,
+	FeedSelectionStrategyAll,
+}
+
+// FeedSelectionConfig は推薦元フィードの選択方法の設定を保持する
+type FeedSelectionConfig struct {
+	Strategy string // フィード選択戦略（空の場合はFeedSelectionStrategyRandom）
+}
+
+// StrategyOrDefault はフィード選択戦略を返す（未設定の場合はFeedSelectionStrategyRandom）
+func (f *FeedSelectionConfig) StrategyOrDefault() string {
+	if f == nil || f.Strategy == "" {
+		return FeedSelectionStrategyRandom
+	}
+	return f.Strategy
+}
+
+// Validate はFeedSelectionConfigの内容をバリデーションする
+func (f *FeedSelectionConfig) Validate() *ValidationResult {
+	builder := NewValidationBuilder()
+
+	if f.Strategy != "" && !slices.Contains(FeedSelectionStrategies, f.Strategy) {
+		builder.AddError(fmt.Sprintf("フィード選択戦略が不正です: %q（%sのいずれかを指定してください）",
+			f.Strategy, strings.Join(FeedSelectionStrategies, ", ")))
+	}
+
+	return builder.Build()
+}
+
+// Merge は他のFeedSelectionConfigの非ゼロ値フィールドで現在のFeedSelectionConfigをマージする
+func (f *FeedSelectionConfig) Merge(other *FeedSelectionConfig) {
+	if other == nil {
+		return
+	}
+	mergeString(&f.Strategy, other.Strategy)
+}
+
+// LogValue はslog出力時に設定値を読みやすく表示するためのメソッド
+func (f FeedSelectionConfig) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("Strategy", f.Strategy),
 	)
 }
 
 type Profile struct {
-	AI     *AIConfig
-	Prompt *PromptConfig
-	Output *OutputConfig
+	AI               *AIConfig
+	Prompt           *PromptConfig
+	Output           *OutputConfig
+	Fetch            *FetchConfig
+	FeedSelection    *FeedSelectionConfig
+	FeedHealth       *FeedHealthConfig
+	Filters          *FilterConfig
+	FullText         *FullTextConfig
+	CanonicalURL     *CanonicalURLConfig
+	Dedup            *DedupConfig
+	TopicSuppression *TopicSuppressionConfig
+	Feeds            []Feed  // 推薦元のフィード一覧（--url/--sourceで指定したフィードに追加される）
+	Seed             *uint64 // フィードと記事のランダムな選択に使う乱数のシード（nilの場合は実行ごとに異なる）
 }
 
 // Validate はProfileの内容をバリデーションする
@@ -520,6 +1603,51 @@ func (p *Profile) Validate() *ValidationResult {
 		builder.MergeResult(p.Output.Validate())
 	}
 
+	// Fetch: 任意項目
+	if p.Fetch != nil {
+		builder.MergeResult(p.Fetch.Validate())
+	}
+
+	// FeedSelection: 任意項目
+	if p.FeedSelection != nil {
+		builder.MergeResult(p.FeedSelection.Validate())
+	}
+
+	// FeedHealth: 任意項目
+	if p.FeedHealth != nil {
+		builder.MergeResult(p.FeedHealth.Validate())
+	}
+
+	// Filters: 任意項目
+	if p.Filters != nil {
+		builder.MergeResult(p.Filters.Validate())
+	}
+
+	// FullText: 任意項目
+	if p.FullText != nil {
+		builder.MergeResult(p.FullText.Validate())
+	}
+
+	// CanonicalURL: 任意項目
+	if p.CanonicalURL != nil {
+		builder.MergeResult(p.CanonicalURL.Validate())
+	}
+
+	// Dedup: 任意項目
+	if p.Dedup != nil {
+		builder.MergeResult(p.Dedup.Validate())
+	}
+
+	// TopicSuppression: 任意項目
+	if p.TopicSuppression != nil {
+		builder.MergeResult(p.TopicSuppression.Validate())
+	}
+
+	// Feeds: 任意項目
+	for i := range p.Feeds {
+		builder.MergeResult(p.Feeds[i].Validate())
+	}
+
 	return builder.Build()
 }
 
@@ -531,6 +1659,21 @@ func (p *Profile) Merge(other *Profile) {
 	mergePtr(&p.AI, other.AI)
 	mergePtr(&p.Prompt, other.Prompt)
 	mergePtr(&p.Output, other.Output)
+	mergePtr(&p.Fetch, other.Fetch)
+	mergePtr(&p.FeedSelection, other.FeedSelection)
+	mergePtr(&p.FeedHealth, other.FeedHealth)
+	mergePtr(&p.Filters, other.Filters)
+	mergePtr(&p.FullText, other.FullText)
+	mergePtr(&p.CanonicalURL, other.CanonicalURL)
+	mergePtr(&p.Dedup, other.Dedup)
+	mergePtr(&p.TopicSuppression, other.TopicSuppression)
+	if other.Seed != nil {
+		p.Seed = other.Seed
+	}
+	// フィード一覧はリストのため、指定されている場合は全体を置き換える
+	if len(other.Feeds) > 0 {
+		p.Feeds = other.Feeds
+	}
 }
 
 // LogValue はslog出力時に機密情報をマスクするためのメソッド
@@ -545,6 +1688,41 @@ func (p Profile) LogValue() slog.Value {
 	if p.Output != nil {
 		attrs = append(attrs, slog.Any("Output", *p.Output)) // OutputConfig.LogValue() が呼ばれる
 	}
+	if p.Fetch != nil {
+		attrs = append(attrs, slog.Any("Fetch", *p.Fetch)) // FetchConfig.LogValue() が呼ばれる
+	}
+	if p.FeedSelection != nil {
+		attrs = append(attrs, slog.Any("FeedSelection", *p.FeedSelection))
+	}
+	if p.FeedHealth != nil {
+		attrs = append(attrs, slog.Any("FeedHealth", *p.FeedHealth))
+	}
+	if p.Filters != nil {
+		attrs = append(attrs, slog.Any("Filters", *p.Filters))
+	}
+	if p.FullText != nil {
+		attrs = append(attrs, slog.Any("FullText", *p.FullText))
+	}
+	if p.CanonicalURL != nil {
+		attrs = append(attrs, slog.Any("CanonicalURL", *p.CanonicalURL))
+	}
+	if p.Dedup != nil {
+		attrs = append(attrs, slog.Any("Dedup", *p.Dedup))
+	}
+	if p.TopicSuppression != nil {
+		attrs = append(attrs, slog.Any("TopicSuppression", *p.TopicSuppression))
+	}
+	if p.Seed != nil {
+		attrs = append(attrs, slog.Uint64("Seed", *p.Seed))
+	}
+	if len(p.Feeds) > 0 {
+		// スライスの要素はLogValue()が呼ばれないため、要素ごとに属性を作成する
+		feedAttrs := make([]any, 0, len(p.Feeds))
+		for i, feed := range p.Feeds {
+			feedAttrs = append(feedAttrs, slog.Any(strconv.Itoa(i), feed)) // Feed.LogValue() が呼ばれる
+		}
+		attrs = append(attrs, slog.Group("Feeds", feedAttrs...))
+	}
 	return slog.GroupValue(attrs...)
 }
 
@@ -571,6 +1749,13 @@ func mergePtr[T any, P interface {
 	}
 }
 
+// mergeSlice はスライスフィールドのマージを行うヘルパー関数（指定されている場合は全体を置き換える）
+func mergeSlice[T any](target *[]T, source []T) {
+	if len(source) > 0 {
+		*target = source
+	}
+}
+
 // mergeString は文字列フィールドのマージを行うヘルパー関数
 func mergeString(target *string, source string) {
 	if source != "" {
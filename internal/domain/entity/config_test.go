@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"log/slog"
 	"testing"
+	"time"
 
 	"github.com/canpok1/ai-feed/internal/testutil"
 	"github.com/stretchr/testify/assert"
@@ -427,6 +428,21 @@ func TestPromptConfig_Validate(t *testing.T) {
 				"記事選択プロンプトが設定されていません",
 			},
 		},
+		{
+			name: "異常系_本文の上限が負の値",
+			config: &PromptConfig{
+				SystemPrompt:          "システムプロンプト",
+				CommentPromptTemplate: "コメントテンプレート",
+				SelectorPrompt:        "記事選択プロンプト",
+				SelectorContentBudget: ContentBudget{MaxChars: -1},
+				CommentContentBudget:  ContentBudget{MaxTokens: -1},
+			},
+			wantErr: true,
+			errors: []string{
+				"記事選択プロンプトに含める本文の最大文字数には0以上の値を指定してください",
+				"コメントプロンプトに含める本文の最大トークン数には0以上の値を指定してください",
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -795,6 +811,602 @@ func TestCacheConfig_Merge(t *testing.T) {
 	}
 }
 
+func TestFetchConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name          string
+		config        *FetchConfig
+		wantIsValid   bool
+		wantErrorsLen int
+	}{
+		{
+			name:        "正常系_空の設定",
+			config:      &FetchConfig{},
+			wantIsValid: true,
+		},
+		{
+			name: "正常系_全項目を設定",
+			config: &FetchConfig{
+				UserAgent:    "ai-feed-test",
+				Timeout:      10 * time.Second,
+				Deadline:     2 * time.Minute,
+				Concurrency:  4,
+				ProxyURL:     "http://proxy.example.com:8080",
+				Headers:      map[string]string{"X-Test": "value"},
+				CABundlePath: "/etc/ssl/internal-ca.pem",
+				MaxBodySize:  1024,
+			},
+			wantIsValid: true,
+		},
+		{
+			name:          "異常系_プロキシURLが不正",
+			config:        &FetchConfig{ProxyURL: "proxy.example.com"},
+			wantIsValid:   false,
+			wantErrorsLen: 1,
+		},
+		{
+			name: "異常系_負の値と空のヘッダー名",
+			config: &FetchConfig{
+				Timeout:     -1 * time.Second,
+				Deadline:    -1 * time.Second,
+				Concurrency: -1,
+				MaxBodySize: -1,
+				Headers:     map[string]string{" ": "value"},
+			},
+			wantIsValid:   false,
+			wantErrorsLen: 5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.config.Validate()
+			assert.Equal(t, tt.wantIsValid, result.IsValid)
+			assert.Len(t, result.Errors, tt.wantErrorsLen)
+		})
+	}
+}
+
+func TestFetchConfig_Merge(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   *FetchConfig
+		source   *FetchConfig
+		expected *FetchConfig
+	}{
+		{
+			name:     "正常系_nilをマージ",
+			target:   &FetchConfig{UserAgent: "original", Timeout: time.Second},
+			source:   nil,
+			expected: &FetchConfig{UserAgent: "original", Timeout: time.Second},
+		},
+		{
+			name: "正常系_部分的な上書きとヘッダーのマージ",
+			target: &FetchConfig{
+				UserAgent:   "original",
+				Timeout:     time.Second,
+				Headers:     map[string]string{"X-A": "a", "X-B": "b"},
+				MaxBodySize: 100,
+			},
+			source: &FetchConfig{
+				Deadline:     time.Minute,
+				Concurrency:  8,
+				ProxyURL:     "http://proxy.example.com",
+				Headers:      map[string]string{"X-B": "overridden", "X-C": "c"},
+				AutoDiscover: testutil.BoolPtr(true),
+			},
+			expected: &FetchConfig{
+				UserAgent:    "original",
+				Timeout:      time.Second,
+				Deadline:     time.Minute,
+				Concurrency:  8,
+				ProxyURL:     "http://proxy.example.com",
+				Headers:      map[string]string{"X-A": "a", "X-B": "overridden", "X-C": "c"},
+				MaxBodySize:  100,
+				AutoDiscover: testutil.BoolPtr(true),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.target.Merge(tt.source)
+			assert.Equal(t, tt.expected, tt.target)
+		})
+	}
+}
+
+func TestFeedSelectionConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      *FeedSelectionConfig
+		wantIsValid bool
+	}{
+		{name: "正常系_未指定", config: &FeedSelectionConfig{}, wantIsValid: true},
+		{name: "正常系_weighted", config: &FeedSelectionConfig{Strategy: FeedSelectionStrategyWeighted}, wantIsValid: true},
+		{name: "正常系_least_recent", config: &FeedSelectionConfig{Strategy: FeedSelectionStrategyLeastRecent}, wantIsValid: true},
+		{name: "異常系_未対応の戦略", config: &FeedSelectionConfig{Strategy: "newest"}, wantIsValid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.config.Validate()
+			assert.Equal(t, tt.wantIsValid, result.IsValid)
+		})
+	}
+}
+
+func TestFeedSelectionConfig_StrategyOrDefault(t *testing.T) {
+	var nilConfig *FeedSelectionConfig
+	assert.Equal(t, FeedSelectionStrategyRandom, nilConfig.StrategyOrDefault())
+	assert.Equal(t, FeedSelectionStrategyRandom, (&FeedSelectionConfig{}).StrategyOrDefault())
+	assert.Equal(t, FeedSelectionStrategyAll, (&FeedSelectionConfig{Strategy: FeedSelectionStrategyAll}).StrategyOrDefault())
+}
+
+func TestProfile_Merge_FeedSelection(t *testing.T) {
+	profile := &Profile{FeedSelection: &FeedSelectionConfig{Strategy: FeedSelectionStrategyWeighted}}
+
+	profile.Merge(&Profile{})
+	assert.Equal(t, FeedSelectionStrategyWeighted, profile.FeedSelection.Strategy)
+
+	profile.Merge(&Profile{FeedSelection: &FeedSelectionConfig{Strategy: FeedSelectionStrategyRoundRobin}})
+	assert.Equal(t, FeedSelectionStrategyRoundRobin, profile.FeedSelection.Strategy)
+}
+
+func TestFeedHealthConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      *FeedHealthConfig
+		wantIsValid bool
+	}{
+		{name: "正常系_未指定", config: &FeedHealthConfig{}, wantIsValid: true},
+		{name: "正常系_指定あり", config: &FeedHealthConfig{FailureThreshold: 5, Cooldown: time.Hour}, wantIsValid: true},
+		{name: "異常系_連続失敗回数が負の値", config: &FeedHealthConfig{FailureThreshold: -1}, wantIsValid: false},
+		{name: "異常系_除外期間が負の値", config: &FeedHealthConfig{Cooldown: -time.Hour}, wantIsValid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.config.Validate()
+			assert.Equal(t, tt.wantIsValid, result.IsValid)
+		})
+	}
+}
+
+func TestFeedHealthConfig_Defaults(t *testing.T) {
+	var nilConfig *FeedHealthConfig
+	assert.True(t, nilConfig.IsEnabled())
+	assert.Equal(t, DefaultFeedFailureThreshold, nilConfig.FailureThresholdOrDefault())
+	assert.Equal(t, DefaultFeedCooldown, nilConfig.CooldownOrDefault())
+
+	config := &FeedHealthConfig{Enabled: testutil.BoolPtr(false), FailureThreshold: 5, Cooldown: time.Hour}
+	assert.False(t, config.IsEnabled())
+	assert.Equal(t, 5, config.FailureThresholdOrDefault())
+	assert.Equal(t, time.Hour, config.CooldownOrDefault())
+}
+
+func TestProfile_Merge_FeedHealth(t *testing.T) {
+	profile := &Profile{FeedHealth: &FeedHealthConfig{FailureThreshold: 5, Cooldown: time.Hour}}
+
+	profile.Merge(&Profile{FeedHealth: &FeedHealthConfig{Enabled: testutil.BoolPtr(false), Cooldown: 2 * time.Hour}})
+
+	assert.False(t, profile.FeedHealth.IsEnabled())
+	assert.Equal(t, 5, profile.FeedHealth.FailureThreshold)
+	assert.Equal(t, 2*time.Hour, profile.FeedHealth.Cooldown)
+}
+
+func TestFilterConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name       string
+		config     *FilterConfig
+		wantErrors []string
+	}{
+		{name: "正常系_未指定", config: &FilterConfig{}},
+		{
+			name: "正常系_すべて指定",
+			config: &FilterConfig{
+				IncludeKeywords:  []string{"go"},
+				ExcludePatterns:  []string{`(?i)sponsored`},
+				Fields:           []string{FilterFieldTitle, FilterFieldURL},
+				MinContentLength: 100,
+				MaxAge:           72 * time.Hour,
+			},
+		},
+		{
+			name:       "異常系_不正な正規表現",
+			config:     &FilterConfig{IncludePatterns: []string{"("}},
+			wantErrors: []string{`記事フィルタの正規表現が不正です: "("`},
+		},
+		{
+			name:       "異常系_不正な照合対象",
+			config:     &FilterConfig{Fields: []string{"author"}},
+			wantErrors: []string{`記事フィルタの照合対象が不正です: "author"（title, content, urlのいずれかを指定してください）`},
+		},
+		{
+			name:   "異常系_負の値",
+			config: &FilterConfig{MinContentLength: -1, MaxAge: -time.Hour},
+			wantErrors: []string{
+				"記事フィルタの本文の最小文字数には0以上の値を指定してください",
+				"記事フィルタの最大経過時間には0以上の値を指定してください",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.config.Validate()
+			assert.Equal(t, len(tt.wantErrors) == 0, result.IsValid)
+			assert.ElementsMatch(t, tt.wantErrors, result.Errors)
+		})
+	}
+}
+
+func TestProfile_Merge_Filters(t *testing.T) {
+	profile := &Profile{Filters: &FilterConfig{
+		ExcludeKeywords:  []string{"sponsored"},
+		BlockedDomains:   []string{"spam.example.com"},
+		MinContentLength: 100,
+	}}
+
+	profile.Merge(&Profile{Filters: &FilterConfig{
+		ExcludeKeywords: []string{"job", "hiring"},
+		MaxAge:          72 * time.Hour,
+	}})
+
+	assert.Equal(t, &FilterConfig{
+		ExcludeKeywords:  []string{"job", "hiring"},
+		BlockedDomains:   []string{"spam.example.com"},
+		MinContentLength: 100,
+		MaxAge:           72 * time.Hour,
+	}, profile.Filters)
+}
+
+func TestFullTextConfig_IsEnabledFor(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   *FullTextConfig
+		feed     Feed
+		expected bool
+	}{
+		{name: "未指定は無効", config: nil, feed: Feed{URL: "https://example.com/feed"}, expected: false},
+		{name: "プロファイルで有効", config: &FullTextConfig{Enabled: testutil.BoolPtr(true)}, feed: Feed{URL: "https://example.com/feed"}, expected: true},
+		{name: "フィードの設定を優先する_有効", config: &FullTextConfig{Enabled: testutil.BoolPtr(false)}, feed: Feed{URL: "https://example.com/feed", FullText: testutil.BoolPtr(true)}, expected: true},
+		{name: "フィードの設定を優先する_無効", config: &FullTextConfig{Enabled: testutil.BoolPtr(true)}, feed: Feed{URL: "https://example.com/feed", FullText: testutil.BoolPtr(false)}, expected: false},
+		{name: "プロファイル未指定でもフィードで有効", config: nil, feed: Feed{URL: "https://example.com/feed", FullText: testutil.BoolPtr(true)}, expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.config.IsEnabledFor(tt.feed))
+		})
+	}
+}
+
+func TestFullTextConfig_Validate(t *testing.T) {
+	assert.True(t, (&FullTextConfig{}).Validate().IsValid)
+	assert.True(t, (&FullTextConfig{MinContentLength: 100}).Validate().IsValid)
+
+	result := (&FullTextConfig{MinContentLength: -1}).Validate()
+	assert.False(t, result.IsValid)
+	assert.Equal(t, []string{"本文を取得する記事の最小文字数には0以上の値を指定してください"}, result.Errors)
+}
+
+func TestProfile_Merge_FullText(t *testing.T) {
+	profile := &Profile{FullText: &FullTextConfig{Enabled: testutil.BoolPtr(true), MinContentLength: 100}}
+
+	profile.Merge(&Profile{FullText: &FullTextConfig{MinContentLength: 300}})
+
+	assert.True(t, *profile.FullText.Enabled)
+	assert.Equal(t, 300, profile.FullText.MinContentLengthOrDefault())
+
+	var nilConfig *FullTextConfig
+	assert.Equal(t, DefaultFullTextMinContentLength, nilConfig.MinContentLengthOrDefault())
+}
+
+func TestCanonicalURLConfig(t *testing.T) {
+	var nilConfig *CanonicalURLConfig
+	assert.False(t, nilConfig.IsResolveEnabled())
+
+	config := &CanonicalURLConfig{StripParams: []string{"ref"}, FollowRedirects: testutil.BoolPtr(true)}
+	assert.True(t, config.Validate().IsValid)
+	assert.True(t, config.IsResolveEnabled())
+	assert.False(t, config.IsCanonicalLinkEnabled())
+
+	assert.False(t, config.IsRewriteAMPPathsEnabled())
+
+	config.Merge(&CanonicalURLConfig{UseCanonicalLink: testutil.BoolPtr(true), RewriteAMPPaths: testutil.BoolPtr(true)})
+	assert.Equal(t, []string{"ref"}, config.StripParams)
+	assert.True(t, config.IsFollowRedirectsEnabled())
+	assert.True(t, config.IsCanonicalLinkEnabled())
+	assert.True(t, config.IsRewriteAMPPathsEnabled())
+
+	result := (&CanonicalURLConfig{StripParams: []string{" "}}).Validate()
+	assert.False(t, result.IsValid)
+	assert.Equal(t, []string{`URLから取り除くクエリパラメータ名が不正です: " "`}, result.Errors)
+}
+
+func TestDedupConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name       string
+		config     *DedupConfig
+		wantErrors []string
+	}{
+		{name: "正常系_未指定", config: &DedupConfig{}},
+		{name: "正常系_指定あり", config: &DedupConfig{TitleSimilarity: 0.9, Keep: DedupKeepEarliest}},
+		{
+			name:       "異常系_類似度が範囲外",
+			config:     &DedupConfig{TitleSimilarity: 1.5},
+			wantErrors: []string{"重複記事とみなすタイトルの類似度には0から1の値を指定してください"},
+		},
+		{
+			name:       "異常系_不正な残し方",
+			config:     &DedupConfig{Keep: "latest"},
+			wantErrors: []string{`重複記事の残し方が不正です: "latest"（richest, earliestのいずれかを指定してください）`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.config.Validate()
+			assert.Equal(t, len(tt.wantErrors) == 0, result.IsValid)
+			assert.ElementsMatch(t, tt.wantErrors, result.Errors)
+		})
+	}
+}
+
+func TestDedupConfig_Defaults(t *testing.T) {
+	var nilConfig *DedupConfig
+	assert.True(t, nilConfig.IsEnabled())
+	assert.Equal(t, DefaultDedupTitleSimilarity, nilConfig.TitleSimilarityOrDefault())
+	assert.Equal(t, DedupKeepRichest, nilConfig.KeepOrDefault())
+
+	config := &DedupConfig{TitleSimilarity: 0.7}
+	config.Merge(&DedupConfig{Enabled: testutil.BoolPtr(false), Keep: DedupKeepEarliest})
+	assert.False(t, config.IsEnabled())
+	assert.Equal(t, 0.7, config.TitleSimilarityOrDefault())
+	assert.Equal(t, DedupKeepEarliest, config.KeepOrDefault())
+}
+
+func TestTopicSuppressionConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name       string
+		config     *TopicSuppressionConfig
+		wantErrors []string
+	}{
+		{name: "正常系_未指定", config: &TopicSuppressionConfig{}},
+		{name: "正常系_指定あり", config: &TopicSuppressionConfig{Similarity: 0.8, Window: 72 * time.Hour, Action: TopicSuppressionActionDemote}},
+		{
+			name:   "異常系_範囲外の値",
+			config: &TopicSuppressionConfig{Similarity: 2, Window: -time.Hour},
+			wantErrors: []string{
+				"同じ話題とみなす類似度には0から1の値を指定してください",
+				"同じ話題を抑制する期間には0以上の値を指定してください",
+			},
+		},
+		{
+			name:       "異常系_不正な扱い",
+			config:     &TopicSuppressionConfig{Action: "hide"},
+			wantErrors: []string{`同じ話題の記事の扱いが不正です: "hide"（drop, demoteのいずれかを指定してください）`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.config.Validate()
+			assert.Equal(t, len(tt.wantErrors) == 0, result.IsValid)
+			assert.ElementsMatch(t, tt.wantErrors, result.Errors)
+		})
+	}
+}
+
+func TestTopicSuppressionConfig_Defaults(t *testing.T) {
+	var nilConfig *TopicSuppressionConfig
+	assert.False(t, nilConfig.IsEnabled())
+	assert.Equal(t, DefaultTopicSimilarity, nilConfig.SimilarityOrDefault())
+	assert.Equal(t, DefaultTopicWindow, nilConfig.WindowOrDefault())
+	assert.Equal(t, TopicSuppressionActionDrop, nilConfig.ActionOrDefault())
+
+	config := &TopicSuppressionConfig{Enabled: testutil.BoolPtr(true), Window: 72 * time.Hour}
+	config.Merge(&TopicSuppressionConfig{Similarity: 0.8, Action: TopicSuppressionActionDemote})
+	assert.True(t, config.IsEnabled())
+	assert.Equal(t, 0.8, config.SimilarityOrDefault())
+	assert.Equal(t, 72*time.Hour, config.WindowOrDefault())
+	assert.Equal(t, TopicSuppressionActionDemote, config.ActionOrDefault())
+}
+
+func TestOpenAIConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *OpenAIConfig
+		errors []string
+	}{
+		{name: "ベースURLは省略できる", config: &OpenAIConfig{Model: "gpt-4o-mini"}},
+		{name: "ローカルのサーバー", config: &OpenAIConfig{BaseURL: "http://localhost:8080/v1", Model: "local"}},
+		{
+			name:   "モデル名が未設定",
+			config: &OpenAIConfig{},
+			errors: []string{"OpenAI設定のモデル名が設定されていません"},
+		},
+		{
+			name:   "スキームのないベースURL",
+			config: &OpenAIConfig{BaseURL: "localhost:11434/v1", Model: "llama3.1"},
+			errors: []string{"OpenAI設定のベースURLにはhttp://またはhttps://で始まるURLを指定してください: localhost:11434/v1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.config.Validate()
+			assert.Equal(t, len(tt.errors) == 0, result.IsValid)
+			if len(tt.errors) > 0 {
+				assert.Equal(t, tt.errors, result.Errors)
+			} else {
+				assert.Empty(t, result.Errors)
+			}
+		})
+	}
+}
+
+func TestOpenAIConfig_Defaults(t *testing.T) {
+	var config *OpenAIConfig
+	assert.Equal(t, DefaultOpenAIBaseURL, config.BaseURLOrDefault())
+	assert.True(t, config.IsJSONModeEnabled())
+
+	config = &OpenAIConfig{BaseURL: "http://localhost:11434/v1/", JSONMode: testutil.BoolPtr(false)}
+	assert.Equal(t, "http://localhost:11434/v1", config.BaseURLOrDefault())
+	assert.False(t, config.IsJSONModeEnabled())
+}
+
+func TestOllamaConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *OllamaConfig
+		errors []string
+	}{
+		{name: "ベースURLは省略できる", config: &OllamaConfig{Model: "llama3.1"}},
+		{name: "keep_aliveは時間の形式", config: &OllamaConfig{Model: "llama3.1", KeepAlive: "30m"}},
+		{name: "keep_aliveは秒数でもよい", config: &OllamaConfig{Model: "llama3.1", KeepAlive: "-1"}},
+		{
+			name:   "モデル名が未設定",
+			config: &OllamaConfig{},
+			errors: []string{"Ollama設定のモデル名が設定されていません"},
+		},
+		{
+			name:   "スキームのないベースURLと不正なkeep_alive",
+			config: &OllamaConfig{BaseURL: "localhost:11434", Model: "llama3.1", KeepAlive: "forever"},
+			errors: []string{
+				"Ollama設定のベースURLにはhttp://またはhttps://で始まるURLを指定してください: localhost:11434",
+				"Ollama設定のkeep_aliveが不正です（例: 5m、1h、-1）: forever",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.config.Validate()
+			assert.Equal(t, len(tt.errors) == 0, result.IsValid)
+			if len(tt.errors) > 0 {
+				assert.Equal(t, tt.errors, result.Errors)
+			} else {
+				assert.Empty(t, result.Errors)
+			}
+		})
+	}
+}
+
+func TestOllamaConfig_BaseURLOrDefault(t *testing.T) {
+	var config *OllamaConfig
+	assert.Equal(t, DefaultOllamaBaseURL, config.BaseURLOrDefault())
+	assert.Equal(t, "http://gpu-server:11434", (&OllamaConfig{BaseURL: "http://gpu-server:11434/"}).BaseURLOrDefault())
+}
+
+func TestAIRetryConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *AIRetryConfig
+		errors []string
+	}{
+		{name: "未設定の場合はデフォルト値", config: &AIRetryConfig{}},
+		{name: "リトライしない", config: &AIRetryConfig{MaxAttempts: 1}},
+		{
+			name:   "負の値",
+			config: &AIRetryConfig{MaxAttempts: -1, MaxInterval: -time.Second},
+			errors: []string{
+				"AI呼び出しの最大試行回数には0以上の値を指定してください",
+				"AI呼び出しのリトライの待ち時間には0以上の値を指定してください",
+			},
+		},
+		{
+			name:   "最初の待ち時間が上限を超えている",
+			config: &AIRetryConfig{InitialInterval: 10 * time.Second, MaxInterval: 5 * time.Second},
+			errors: []string{"AI呼び出しの最初のリトライまでの待ち時間（10s）が待ち時間の上限（5s）を超えています"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.config.Validate()
+			assert.Equal(t, len(tt.errors) == 0, result.IsValid)
+			if len(tt.errors) > 0 {
+				assert.Equal(t, tt.errors, result.Errors)
+			} else {
+				assert.Empty(t, result.Errors)
+			}
+		})
+	}
+}
+
+func TestAIRetryConfig_Defaults(t *testing.T) {
+	var config *AIRetryConfig
+	assert.Equal(t, DefaultAIRetryMaxAttempts, config.MaxAttemptsOrDefault())
+	assert.Equal(t, DefaultAIRetryInitialInterval, config.InitialIntervalOrDefault())
+	assert.Equal(t, DefaultAIRetryMaxInterval, config.MaxIntervalOrDefault())
+
+	config = &AIRetryConfig{}
+	config.Merge(&AIRetryConfig{MaxAttempts: 5, MaxInterval: time.Minute})
+	assert.Equal(t, 5, config.MaxAttemptsOrDefault())
+	assert.Equal(t, DefaultAIRetryInitialInterval, config.InitialIntervalOrDefault())
+	assert.Equal(t, time.Minute, config.MaxIntervalOrDefault())
+}
+
+func TestAIConfig_ValidateChain(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *AIConfig
+		errors []string
+	}{
+		{
+			name:   "設定のないプロバイダがあってもmockで補える",
+			config: &AIConfig{Chain: []string{"gemini", "openai", "mock"}},
+		},
+		{
+			name: "設定が揃ったプロバイダがある",
+			config: &AIConfig{
+				Gemini: &GeminiConfig{Type: "gemini-2.5-flash"},
+				Ollama: &OllamaConfig{Model: "llama3.1"},
+				Chain:  []string{"gemini", "ollama"},
+			},
+		},
+		{
+			name:   "不明なプロバイダ",
+			config: &AIConfig{Chain: []string{"claude", "mock"}},
+			errors: []string{"AIの呼び出し順に指定したプロバイダが不正です。gemini, openai, ollama, mockのいずれかを指定してください: claude"},
+		},
+		{
+			name:   "プロバイダの重複",
+			config: &AIConfig{Chain: []string{"mock", "mock"}},
+			errors: []string{"AIの呼び出し順に同じプロバイダが重複しています: mock"},
+		},
+		{
+			name: "使用できるプロバイダがない",
+			config: &AIConfig{
+				Gemini: &GeminiConfig{Type: "gemini-2.5-flash"},
+				Chain:  []string{"gemini", "openai"},
+			},
+			errors: []string{"AIの呼び出し順に使用できるプロバイダがありません。いずれかのプロバイダを設定するか、mockを追加してください"},
+		},
+		{
+			name: "mockの記事選択モードが不正",
+			config: &AIConfig{
+				Mock:  &MockConfig{SelectorMode: "middle"},
+				Chain: []string{"mock"},
+			},
+			errors: []string{"AIの呼び出し順に使用できるプロバイダがありません。いずれかのプロバイダを設定するか、mockを追加してください"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.config.Validate()
+			assert.Equal(t, len(tt.errors) == 0, result.IsValid)
+			if len(tt.errors) > 0 {
+				assert.Equal(t, tt.errors, result.Errors)
+			} else {
+				assert.Empty(t, result.Errors)
+			}
+		})
+	}
+}
+
 func TestAIConfig_Validate(t *testing.T) {
 	makeSecretString := func(value string) SecretString {
 		return NewSecretString(value)
@@ -897,6 +1509,20 @@ func TestAIConfig_Validate(t *testing.T) {
 			wantErr: true,
 			errors:  []string{"Gemini設定のTypeが設定されていません"},
 		},
+		{
+			name: "正常系_OpenAI互換APIの設定がある場合Gemini不要",
+			config: &AIConfig{
+				OpenAI: &OpenAIConfig{BaseURL: "http://localhost:11434/v1", Model: "llama3.1"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "正常系_Ollamaの設定がある場合Gemini不要",
+			config: &AIConfig{
+				Ollama: &OllamaConfig{Model: "llama3.1"},
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -1156,6 +1782,29 @@ func TestPromptConfig_Merge(t *testing.T) {
 	}
 }
 
+func TestPromptConfig_ContentBudget(t *testing.T) {
+	t.Run("未設定の場合はデフォルトの文字数を使用する", func(t *testing.T) {
+		config := &PromptConfig{}
+		assert.Equal(t, ContentBudget{MaxChars: DefaultSelectorContentMaxChars}, config.SelectorContentBudgetOrDefault())
+		assert.Equal(t, ContentBudget{MaxChars: DefaultCommentContentMaxChars}, config.CommentContentBudgetOrDefault())
+	})
+
+	t.Run("設定した上限を使用する", func(t *testing.T) {
+		config := &PromptConfig{
+			SelectorContentBudget: ContentBudget{MaxChars: 100},
+			CommentContentBudget:  ContentBudget{MaxTokens: 1000},
+		}
+		assert.Equal(t, ContentBudget{MaxChars: 100}, config.SelectorContentBudgetOrDefault())
+		assert.Equal(t, ContentBudget{MaxChars: DefaultCommentContentMaxChars, MaxTokens: 1000}, config.CommentContentBudgetOrDefault())
+	})
+
+	t.Run("非ゼロ値のみマージする", func(t *testing.T) {
+		config := &PromptConfig{SelectorContentBudget: ContentBudget{MaxChars: 100, MaxTokens: 50}}
+		config.Merge(&PromptConfig{SelectorContentBudget: ContentBudget{MaxTokens: 80}})
+		assert.Equal(t, ContentBudget{MaxChars: 100, MaxTokens: 80}, config.SelectorContentBudget)
+	})
+}
+
 func TestPromptConfig_BuildCommentPrompt(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -1,15 +1,52 @@
 package entity
 
 import (
+	"strings"
 	"time"
 )
 
 // Article represents a single article in a feed.
 type Article struct {
-	Title     string
-	Link      string
-	Published *time.Time
-	Content   string
+	Title string
+	Link  string
+	// OriginalLink は正規化する前にフィードに記載されていたURL（正規化でLinkが変わった場合のみ設定）
+	OriginalLink string
+	Published    *time.Time
+	// Updated はフィードに記載された記事の更新日時
+	Updated *time.Time
+	Content string
+	// FeedContent は記事ページから取得した本文でContentを置き換える前にフィードに記載されていた本文（置き換えた場合のみ設定）
+	FeedContent string
+	// Author は記事の著者（複数いる場合はカンマ区切り）
+	Author string
+	// GUID はフィード内で記事を識別するID
+	GUID string
+	// ImageURL は記事の代表画像のURL
+	ImageURL string
+	// Categories はフィードで記事に付けられたカテゴリ
+	Categories []string
+	// Enclosures は記事に添付されたファイル
+	Enclosures []Enclosure
+	// 記事を取得したフィードの情報
+	FeedURL  string
+	FeedName string
+	// FeedTitle はフィード自体に記載されたタイトル
+	FeedTitle string
+	FeedTags  []string
+	// SourceFeedURLs は同じ記事を配信していたフィードのURL（複数のフィードの重複記事をまとめた場合のみ設定）
+	SourceFeedURLs []string
+}
+
+// Enclosure は記事に添付されたファイル（画像や音声など）を表す
+type Enclosure struct {
+	URL    string
+	Type   string
+	Length int64
+}
+
+// CategoriesText はカテゴリをカンマ区切りでつなげた文字列を返す
+func (a Article) CategoriesText() string {
+	return strings.Join(a.Categories, ", ")
 }
 
 // Validate はArticleの内容をバリデーションする
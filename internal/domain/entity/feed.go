@@ -0,0 +1,200 @@
+package entity
+
+import (
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// フィードの認証方式
+const (
+	// FeedAuthTypeBasic はBasic認証
+	FeedAuthTypeBasic = "basic"
+	// FeedAuthTypeBearer はAuthorizationヘッダーによるBearerトークン認証
+	FeedAuthTypeBearer = "bearer"
+	// FeedAuthTypeHeader は任意のヘッダーにトークンを設定する認証（例: GitLabのPRIVATE-TOKEN）
+	FeedAuthTypeHeader = "header"
+)
+
+// DefaultFeedWeight はフィードの選択重みのデフォルト値
+const DefaultFeedWeight = 1.0
+
+// FeedURLStdin はフィードを標準入力から読み込むことを表すURL
+const FeedURLStdin = "-"
+
+// Feed は推薦元となるフィードの定義を表す
+type Feed struct {
+	URL     string
+	Name    string   // 表示名（省略時はURLを表示に使用する）
+	Tags    []string // フィードの分類に使用するタグ
+	Weight  float64  // フィード選択時の重み（0の場合はDefaultFeedWeightとして扱う）
+	Enabled *bool    // 有効/無効フラグ（nilの場合は有効）
+	Auth    *FeedAuth
+	Headers map[string]string // このフィードへのリクエストにのみ付与する追加ヘッダー
+	// FullText は本文が短い記事で記事ページから本文を取得するかどうか（nilの場合はプロファイルの設定に従う）
+	FullText *bool
+}
+
+// FeedAuth はフィード取得時の認証情報を表す
+type FeedAuth struct {
+	Type       string // "basic", "bearer", "header"
+	Username   string // basicの場合に使用
+	Password   SecretString
+	Token      SecretString // bearer, headerの場合に使用
+	HeaderName string       // headerの場合にトークンを設定するヘッダー名
+}
+
+// NewFeedsFromURLs はURLのみを指定したフィード定義の一覧を作成する
+func NewFeedsFromURLs(urls []string) []Feed {
+	feeds := make([]Feed, 0, len(urls))
+	for _, url := range urls {
+		feeds = append(feeds, Feed{URL: url})
+	}
+	return feeds
+}
+
+// IsEnabled はフィードが有効かどうかを返す（未設定の場合は有効）
+func (f *Feed) IsEnabled() bool {
+	return f.Enabled == nil || *f.Enabled
+}
+
+// DisplayName は表示用のフィード名を返す（Name未設定の場合はURL）
+func (f *Feed) DisplayName() string {
+	if f.Name != "" {
+		return f.Name
+	}
+	return f.URL
+}
+
+// SelectionWeight はフィード選択時の重みを返す（未設定の場合はDefaultFeedWeight）
+func (f *Feed) SelectionWeight() float64 {
+	if f.Weight <= 0 {
+		return DefaultFeedWeight
+	}
+	return f.Weight
+}
+
+// IsLocal はフィードがローカルのファイル・ディレクトリまたは標準入力から読み込むものかどうかを返す
+func (f *Feed) IsLocal() bool {
+	return IsLocalFeedURL(f.URL)
+}
+
+// IsLocalFeedURL はフィードのURLが標準入力（-）、file:// のURL、またはファイルパスかどうかを返す
+// ホスト名の書き間違いと区別するため、ファイルパスは絶対パスか ./ ../ で始まる相対パスのみとする
+func IsLocalFeedURL(feedURL string) bool {
+	switch {
+	case feedURL == FeedURLStdin:
+		return true
+	case strings.HasPrefix(strings.ToLower(feedURL), "file:"):
+		return true
+	case filepath.IsAbs(feedURL):
+		return true
+	}
+	for _, prefix := range []string{"./", "../", `.\`, `..\`} {
+		if strings.HasPrefix(feedURL, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate はFeedの内容をバリデーションする
+func (f *Feed) Validate() *ValidationResult {
+	builder := NewValidationBuilder()
+
+	// URL: 必須項目、URL形式またはローカルのファイルパスであること
+	if !f.IsLocal() {
+		if err := ValidateURL(f.URL, "フィードのURL"); err != nil {
+			builder.AddError(err.Error())
+		}
+	}
+
+	if f.Weight < 0 {
+		builder.AddError(fmt.Sprintf("フィード(%s)の重みには0以上の値を指定してください", f.URL))
+	}
+
+	for name := range f.Headers {
+		if strings.TrimSpace(name) == "" {
+			builder.AddError(fmt.Sprintf("フィード(%s)の追加ヘッダーに空のヘッダー名が含まれています", f.URL))
+			break
+		}
+	}
+
+	// 無効なフィードは認証情報を解決しないため、認証情報のバリデーションをスキップ
+	if f.Auth != nil && f.IsEnabled() {
+		for _, errMsg := range f.Auth.Validate().Errors {
+			builder.AddError(fmt.Sprintf("フィード(%s): %s", f.URL, errMsg))
+		}
+	}
+
+	return builder.Build()
+}
+
+// Validate はFeedAuthの内容をバリデーションする
+func (a *FeedAuth) Validate() *ValidationResult {
+	builder := NewValidationBuilder()
+
+	switch a.Type {
+	case FeedAuthTypeBasic:
+		if err := ValidateRequired(a.Username, "Basic認証のユーザー名"); err != nil {
+			builder.AddError(err.Error())
+		}
+		if a.Password.IsEmpty() {
+			builder.AddError("Basic認証のパスワードが設定されていません")
+		}
+	case FeedAuthTypeBearer:
+		if a.Token.IsEmpty() {
+			builder.AddError("Bearer認証のトークンが設定されていません")
+		}
+	case FeedAuthTypeHeader:
+		if err := ValidateRequired(a.HeaderName, "認証ヘッダー名"); err != nil {
+			builder.AddError(err.Error())
+		}
+		if a.Token.IsEmpty() {
+			builder.AddError("認証ヘッダーのトークンが設定されていません")
+		}
+	default:
+		builder.AddError(fmt.Sprintf("認証方式が不正です: %q（basic, bearer, headerのいずれかを指定してください）", a.Type))
+	}
+
+	return builder.Build()
+}
+
+// LogValue はslog出力時に機密情報をマスクするためのメソッド
+// ヘッダーの値には認証情報が含まれる可能性があるため、ヘッダー名のみを出力する
+func (f Feed) LogValue() slog.Value {
+	headerNames := make([]string, 0, len(f.Headers))
+	for name := range f.Headers {
+		headerNames = append(headerNames, name)
+	}
+	sort.Strings(headerNames)
+
+	attrs := []slog.Attr{
+		slog.String("URL", f.URL),
+		slog.String("Name", f.Name),
+		slog.Any("Tags", f.Tags),
+		slog.Float64("Weight", f.SelectionWeight()),
+		slog.Bool("Enabled", f.IsEnabled()),
+		slog.Any("HeaderNames", headerNames),
+	}
+	if f.Auth != nil {
+		attrs = append(attrs, slog.String("AuthType", f.Auth.Type))
+	}
+	if f.FullText != nil {
+		attrs = append(attrs, slog.Bool("FullText", *f.FullText))
+	}
+	return slog.GroupValue(attrs...)
+}
+
+// FilterEnabledFeeds は有効なフィードのみを返す
+func FilterEnabledFeeds(feeds []Feed) []Feed {
+	enabled := make([]Feed, 0, len(feeds))
+	for _, feed := range feeds {
+		if feed.IsEnabled() {
+			enabled = append(enabled, feed)
+		}
+	}
+	return enabled
+}
@@ -0,0 +1,131 @@
+package entity
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFeed_Validate(t *testing.T) {
+	tests := []struct {
+		name          string
+		feed          Feed
+		wantIsValid   bool
+		wantErrorsLen int
+	}{
+		{
+			name:        "正常系_URLのみ",
+			feed:        Feed{URL: "https://example.com/feed.xml"},
+			wantIsValid: true,
+		},
+		{
+			name: "正常系_Basic認証",
+			feed: Feed{
+				URL:  "https://example.com/feed.xml",
+				Auth: &FeedAuth{Type: FeedAuthTypeBasic, Username: "user", Password: NewSecretString("pass")},
+			},
+			wantIsValid: true,
+		},
+		{
+			name: "正常系_ヘッダー認証",
+			feed: Feed{
+				URL:  "https://example.com/feed.xml",
+				Auth: &FeedAuth{Type: FeedAuthTypeHeader, HeaderName: "PRIVATE-TOKEN", Token: NewSecretString("token")},
+			},
+			wantIsValid: true,
+		},
+		{
+			name:        "正常系_ローカルのファイル",
+			feed:        Feed{URL: "./testdata/feed.xml"},
+			wantIsValid: true,
+		},
+		{
+			name:        "正常系_標準入力",
+			feed:        Feed{URL: FeedURLStdin},
+			wantIsValid: true,
+		},
+		{
+			name:          "異常系_URLが不正",
+			feed:          Feed{URL: "example.com/feed.xml"},
+			wantIsValid:   false,
+			wantErrorsLen: 1,
+		},
+		{
+			name: "異常系_Bearer認証のトークンが未設定",
+			feed: Feed{
+				URL:  "https://example.com/feed.xml",
+				Auth: &FeedAuth{Type: FeedAuthTypeBearer},
+			},
+			wantIsValid:   false,
+			wantErrorsLen: 1,
+		},
+		{
+			name: "異常系_ヘッダー認証のヘッダー名とトークンが未設定",
+			feed: Feed{
+				URL:  "https://example.com/feed.xml",
+				Auth: &FeedAuth{Type: FeedAuthTypeHeader},
+			},
+			wantIsValid:   false,
+			wantErrorsLen: 2,
+		},
+		{
+			name: "異常系_未対応の認証方式",
+			feed: Feed{
+				URL:  "https://example.com/feed.xml",
+				Auth: &FeedAuth{Type: "digest"},
+			},
+			wantIsValid:   false,
+			wantErrorsLen: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.feed.Validate()
+			assert.Equal(t, tt.wantIsValid, result.IsValid)
+			assert.Len(t, result.Errors, tt.wantErrorsLen)
+		})
+	}
+}
+
+func TestIsLocalFeedURL(t *testing.T) {
+	tests := []struct {
+		feedURL string
+		want    bool
+	}{
+		{feedURL: "-", want: true},
+		{feedURL: "file:///var/feeds/feed.xml", want: true},
+		{feedURL: "/var/feeds", want: true},
+		{feedURL: "./feeds/feed.xml", want: true},
+		{feedURL: "../feed.json", want: true},
+		{feedURL: "https://example.com/feed.xml", want: false},
+		{feedURL: "example.com/feed.xml", want: false},
+		{feedURL: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.feedURL, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsLocalFeedURL(tt.feedURL))
+		})
+	}
+}
+
+func TestFeed_LogValue(t *testing.T) {
+	feed := Feed{
+		URL:     "https://example.com/feed.xml",
+		Auth:    &FeedAuth{Type: FeedAuthTypeBearer, Token: NewSecretString("secret-token")},
+		Headers: map[string]string{"X-Api-Key": "secret-key"},
+	}
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+	logger.Info("test", "feed", feed)
+
+	output := buf.String()
+	assert.Contains(t, output, "https://example.com/feed.xml")
+	assert.Contains(t, output, "X-Api-Key")
+	assert.NotContains(t, output, "secret-token")
+	assert.NotContains(t, output, "secret-key")
+}
@@ -20,15 +20,29 @@ var messageTemplateAliasMap = map[string]string{
 	"CONTENT":       ".Article.Content",
 	"COMMENT":       ".Comment",
 	"FIXED_MESSAGE": ".FixedMessage",
+	"FEED_NAME":     ".Article.FeedName",
+	"FEED_URL":      ".Article.FeedURL",
+	"FEED_TITLE":    ".Article.FeedTitle",
+	"AUTHOR":        ".Article.Author",
+	"CATEGORIES":    ".Article.CategoriesText",
+	"GUID":          ".Article.GUID",
+	"IMAGE_URL":     ".Article.ImageURL",
 }
 
 // NewPromptTemplateAliasConverter はPromptConfig用の別名変換器を作成する
 func NewPromptTemplateAliasConverter() *TemplateAliasConverter {
 	return &TemplateAliasConverter{
 		aliasMap: map[string]string{
-			"TITLE":   ".Title",
-			"URL":     ".Link",
-			"CONTENT": ".Content",
+			"TITLE":      ".Title",
+			"URL":        ".Link",
+			"CONTENT":    ".Content",
+			"FEED_NAME":  ".FeedName",
+			"FEED_URL":   ".FeedURL",
+			"FEED_TITLE": ".FeedTitle",
+			"AUTHOR":     ".Author",
+			"CATEGORIES": ".CategoriesText",
+			"GUID":       ".GUID",
+			"IMAGE_URL":  ".ImageURL",
 		},
 	}
 }
@@ -11,22 +11,36 @@ func TestNewPromptTemplateAliasConverter(t *testing.T) {
 	converter := NewPromptTemplateAliasConverter()
 	assert.NotNil(t, converter)
 	assert.NotNil(t, converter.aliasMap)
-	assert.Equal(t, 3, len(converter.aliasMap))
+	assert.Equal(t, 10, len(converter.aliasMap))
 	assert.Equal(t, ".Title", converter.aliasMap["TITLE"])
 	assert.Equal(t, ".Link", converter.aliasMap["URL"])
 	assert.Equal(t, ".Content", converter.aliasMap["CONTENT"])
+	assert.Equal(t, ".FeedName", converter.aliasMap["FEED_NAME"])
+	assert.Equal(t, ".FeedURL", converter.aliasMap["FEED_URL"])
+	assert.Equal(t, ".FeedTitle", converter.aliasMap["FEED_TITLE"])
+	assert.Equal(t, ".Author", converter.aliasMap["AUTHOR"])
+	assert.Equal(t, ".CategoriesText", converter.aliasMap["CATEGORIES"])
+	assert.Equal(t, ".GUID", converter.aliasMap["GUID"])
+	assert.Equal(t, ".ImageURL", converter.aliasMap["IMAGE_URL"])
 }
 
 func TestNewSlackTemplateAliasConverter(t *testing.T) {
 	converter := NewSlackTemplateAliasConverter()
 	assert.NotNil(t, converter)
 	assert.NotNil(t, converter.aliasMap)
-	assert.Equal(t, 5, len(converter.aliasMap))
+	assert.Equal(t, 12, len(converter.aliasMap))
 	assert.Equal(t, ".Article.Title", converter.aliasMap["TITLE"])
 	assert.Equal(t, ".Article.Link", converter.aliasMap["URL"])
 	assert.Equal(t, ".Article.Content", converter.aliasMap["CONTENT"])
 	assert.Equal(t, ".Comment", converter.aliasMap["COMMENT"])
 	assert.Equal(t, ".FixedMessage", converter.aliasMap["FIXED_MESSAGE"])
+	assert.Equal(t, ".Article.FeedName", converter.aliasMap["FEED_NAME"])
+	assert.Equal(t, ".Article.FeedURL", converter.aliasMap["FEED_URL"])
+	assert.Equal(t, ".Article.FeedTitle", converter.aliasMap["FEED_TITLE"])
+	assert.Equal(t, ".Article.Author", converter.aliasMap["AUTHOR"])
+	assert.Equal(t, ".Article.CategoriesText", converter.aliasMap["CATEGORIES"])
+	assert.Equal(t, ".Article.GUID", converter.aliasMap["GUID"])
+	assert.Equal(t, ".Article.ImageURL", converter.aliasMap["IMAGE_URL"])
 }
 
 func TestPromptTemplateAliasConverter_Convert(t *testing.T) {
@@ -142,6 +156,18 @@ func TestSlackTemplateAliasConverter_Convert(t *testing.T) {
 			expected:    "{{.Article.Title}} {{.Article.Link}} {{.Article.Content}} {{.Comment}} {{.FixedMessage}}",
 			expectError: false,
 		},
+		{
+			name:        "フィード情報の別名記法",
+			input:       "[{{FEED_NAME}}]({{FEED_URL}}) {{URL}}",
+			expected:    "[{{.Article.FeedName}}]({{.Article.FeedURL}}) {{.Article.Link}}",
+			expectError: false,
+		},
+		{
+			name:        "記事のメタデータの別名記法",
+			input:       "{{AUTHOR}} {{CATEGORIES}} {{GUID}} {{IMAGE_URL}} {{FEED_TITLE}}",
+			expected:    "{{.Article.Author}} {{.Article.CategoriesText}} {{.Article.GUID}} {{.Article.ImageURL}} {{.Article.FeedTitle}}",
+			expectError: false,
+		},
 		{
 			name:        "新旧記法の混在",
 			input:       "{{TITLE}} - {{.Article.Link}} - {{COMMENT}}",
@@ -165,10 +191,10 @@ func TestSlackTemplateAliasConverter_Convert(t *testing.T) {
 		},
 		{
 			name:        "存在しないパラメータ",
-			input:       "{{AUTHOR}}",
+			input:       "{{UNKNOWN}}",
 			expected:    "",
 			expectError: true,
-			errorMsg:    "存在しないパラメータです: '{{AUTHOR}}'",
+			errorMsg:    "存在しないパラメータです: '{{UNKNOWN}}'",
 		},
 	}
 
@@ -301,24 +327,28 @@ func TestGetValidAliases(t *testing.T) {
 		converter := NewPromptTemplateAliasConverter()
 		aliases := converter.getValidAliases()
 
-		assert.Equal(t, 3, len(aliases))
+		assert.Equal(t, 10, len(aliases))
 		// マップの順序は保証されないので、要素の存在だけ確認
 		aliasesStr := strings.Join(aliases, " ")
 		assert.Contains(t, aliasesStr, "{{TITLE}}")
 		assert.Contains(t, aliasesStr, "{{URL}}")
 		assert.Contains(t, aliasesStr, "{{CONTENT}}")
+		assert.Contains(t, aliasesStr, "{{FEED_NAME}}")
+		assert.Contains(t, aliasesStr, "{{FEED_URL}}")
 	})
 
 	t.Run("SlackConverter", func(t *testing.T) {
 		converter := NewSlackTemplateAliasConverter()
 		aliases := converter.getValidAliases()
 
-		assert.Equal(t, 5, len(aliases))
+		assert.Equal(t, 12, len(aliases))
 		aliasesStr := strings.Join(aliases, " ")
 		assert.Contains(t, aliasesStr, "{{TITLE}}")
 		assert.Contains(t, aliasesStr, "{{URL}}")
 		assert.Contains(t, aliasesStr, "{{CONTENT}}")
 		assert.Contains(t, aliasesStr, "{{COMMENT}}")
 		assert.Contains(t, aliasesStr, "{{FIXED_MESSAGE}}")
+		assert.Contains(t, aliasesStr, "{{FEED_NAME}}")
+		assert.Contains(t, aliasesStr, "{{FEED_URL}}")
 	})
 }
@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+)
+
+// FeedCheckStatus はフィードの検査結果の状態を表す
+type FeedCheckStatus string
+
+const (
+	// FeedCheckStatusOK は取得と解析に成功し、記事が含まれている状態
+	FeedCheckStatusOK FeedCheckStatus = "ok"
+	// FeedCheckStatusEmpty は取得と解析に成功したが、記事が含まれていない状態
+	FeedCheckStatusEmpty FeedCheckStatus = "empty"
+	// FeedCheckStatusError は取得または解析に失敗した状態
+	FeedCheckStatusError FeedCheckStatus = "error"
+)
+
+// FeedCheckResult は1フィードの検査結果を表す
+type FeedCheckResult struct {
+	Feed   entity.Feed
+	Status FeedCheckStatus
+	// ItemCount はフィードに含まれる記事数
+	ItemCount int
+	// ItemsWithContent は本文（contentまたはdescription）を持つ記事数
+	ItemsWithContent int
+	// LatestPublished は最も新しい記事の公開日時（公開日時を持つ記事がない場合はnil）
+	LatestPublished *time.Time
+	// Err は取得または解析のエラー
+	Err error
+	// Duration は取得にかかった時間
+	Duration time.Duration
+}
+
+// IsBroken はフィードが壊れている（取得または解析に失敗した）かどうかを返す
+func (r *FeedCheckResult) IsBroken() bool {
+	return r.Status == FeedCheckStatusError
+}
+
+// FeedChecker はフィードを取得して、記事数や公開日時などを検査する
+// 並行取得と1フィードあたりのタイムアウトはFetcherと共通の処理を使う
+type FeedChecker struct {
+	fetcher *Fetcher
+	now     func() time.Time
+}
+
+// NewFeedChecker はFeedCheckerを作成する
+// optionsのConcurrencyとFeedTimeoutを検査時の並行数とタイムアウトとして使用する
+func NewFeedChecker(client FetchClient, options FetcherOptions) *FeedChecker {
+	return &FeedChecker{
+		fetcher: NewFetcherWithOptions(client, nil, options),
+		now:     time.Now,
+	}
+}
+
+// Check はすべてのフィードを並行に取得して検査し、入力と同じ順序で結果を返す
+// 呼び出し元のキャンセル時はエラーを返す
+func (c *FeedChecker) Check(ctx context.Context, feeds []entity.Feed) ([]FeedCheckResult, error) {
+	results := make([]FeedCheckResult, len(feeds))
+	c.fetcher.forEachFeed(len(feeds), func(i int) {
+		results[i] = c.checkOne(ctx, feeds[i])
+	})
+
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("feed check interrupted: %w", err)
+	}
+	return results, nil
+}
+
+// checkOne は1フィードあたりのタイムアウトを適用してフィードを検査する
+func (c *FeedChecker) checkOne(ctx context.Context, feed entity.Feed) FeedCheckResult {
+	result := FeedCheckResult{Feed: feed}
+
+	startedAt := c.now()
+	articles, err := c.fetcher.fetchOne(ctx, feed)
+	result.Duration = c.now().Sub(startedAt)
+	if err != nil {
+		result.Status = FeedCheckStatusError
+		result.Err = err
+		return result
+	}
+
+	result.ItemCount = len(articles)
+	for i := range articles {
+		if articles[i].Content != "" {
+			result.ItemsWithContent++
+		}
+		published := articles[i].Published
+		if published != nil && (result.LatestPublished == nil || published.After(*result.LatestPublished)) {
+			result.LatestPublished = published
+		}
+	}
+
+	if result.ItemCount == 0 {
+		result.Status = FeedCheckStatusEmpty
+	} else {
+		result.Status = FeedCheckStatusOK
+	}
+	return result
+}
@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeedChecker_Check(t *testing.T) {
+	older := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	mockClient := newMockFetchClient()
+	mockClient.setResponse("https://ok.example.com/feed", []entity.Article{
+		{Title: "Old", Published: &older, Content: "body"},
+		{Title: "New", Published: &newer},
+		{Title: "No date"},
+	})
+	mockClient.setResponse("https://empty.example.com/feed", []entity.Article{})
+	mockClient.setError("https://broken.example.com/feed", errors.New("failed to detect feed type"))
+
+	checker := NewFeedChecker(mockClient, FetcherOptions{Concurrency: 2})
+	results, err := checker.Check(context.Background(), entity.NewFeedsFromURLs([]string{
+		"https://ok.example.com/feed",
+		"https://empty.example.com/feed",
+		"https://broken.example.com/feed",
+	}))
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	ok := results[0]
+	assert.Equal(t, "https://ok.example.com/feed", ok.Feed.URL)
+	assert.Equal(t, FeedCheckStatusOK, ok.Status)
+	assert.Equal(t, 3, ok.ItemCount)
+	assert.Equal(t, 1, ok.ItemsWithContent)
+	require.NotNil(t, ok.LatestPublished)
+	assert.Equal(t, newer, *ok.LatestPublished)
+	assert.False(t, ok.IsBroken())
+
+	empty := results[1]
+	assert.Equal(t, FeedCheckStatusEmpty, empty.Status)
+	assert.Nil(t, empty.LatestPublished)
+	assert.False(t, empty.IsBroken())
+
+	broken := results[2]
+	assert.Equal(t, FeedCheckStatusError, broken.Status)
+	assert.EqualError(t, broken.Err, "failed to detect feed type")
+	assert.True(t, broken.IsBroken())
+}
+
+func TestFeedChecker_Check_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	checker := NewFeedChecker(newMockFetchClient(), FetcherOptions{Concurrency: 1})
+	_, err := checker.Check(ctx, entity.NewFeedsFromURLs([]string{"https://example.com/feed"}))
+
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,23 @@
+package domain
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrFeedNotDiscovered はWebページからフィードを見つけられなかった場合のエラー
+var ErrFeedNotDiscovered = errors.New("no feed discovered")
+
+// DiscoveredFeed はWebページから見つけたフィードを表す
+type DiscoveredFeed struct {
+	URL   string
+	Title string
+	Type  string // rss, atom, json
+}
+
+// FeedDiscoverer はWebページのURLからフィードのURLを探すインターフェース
+type FeedDiscoverer interface {
+	// Discover はページのURLからフィードを探す
+	// ページ自体がフィードの場合はそのURLを返し、見つからない場合はErrFeedNotDiscoveredを返す
+	Discover(ctx context.Context, pageURL string) ([]DiscoveredFeed, error)
+}
@@ -0,0 +1,12 @@
+package domain
+
+import (
+	"io"
+
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+)
+
+// FeedExporter はフィード一覧を他のリーダーで読み込める形式で書き出すインターフェース
+type FeedExporter interface {
+	Export(w io.Writer, feeds []entity.Feed) error
+}
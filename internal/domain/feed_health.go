@@ -0,0 +1,124 @@
+package domain
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+)
+
+// FeedHealthStatus はフィードの健全性の状態を表す
+type FeedHealthStatus string
+
+const (
+	// FeedHealthStatusUnknown はまだ取得の記録がない状態
+	FeedHealthStatusUnknown FeedHealthStatus = "unknown"
+	// FeedHealthStatusOK は直近の取得に成功している状態
+	FeedHealthStatusOK FeedHealthStatus = "ok"
+	// FeedHealthStatusFailing は直近の取得に失敗しているが、取得対象から外すほどではない状態
+	FeedHealthStatusFailing FeedHealthStatus = "failing"
+	// FeedHealthStatusQuarantined は連続失敗により一時的に取得対象から外している状態
+	FeedHealthStatusQuarantined FeedHealthStatus = "quarantined"
+)
+
+// FeedHealth はフィードの健全性の記録と状態をまとめたもの
+type FeedHealth struct {
+	Feed   entity.Feed
+	State  FeedState
+	Status FeedHealthStatus
+}
+
+// FeedHealthMonitor はフィードごとの取得結果をFeedStateStoreに記録し、
+// 連続して失敗したフィードを一定期間取得対象から外す
+type FeedHealthMonitor struct {
+	stateStore       FeedStateStore
+	quarantine       bool
+	failureThreshold int
+	cooldown         time.Duration
+	now              func() time.Time
+}
+
+// NewFeedHealthMonitor はFeedHealthMonitorを作成する
+// configがnilの場合はデフォルトのしきい値と期間で自動除外を行う
+func NewFeedHealthMonitor(stateStore FeedStateStore, config *entity.FeedHealthConfig) *FeedHealthMonitor {
+	return &FeedHealthMonitor{
+		stateStore:       stateStore,
+		quarantine:       config.IsEnabled(),
+		failureThreshold: config.FailureThresholdOrDefault(),
+		cooldown:         config.CooldownOrDefault(),
+		now:              time.Now,
+	}
+}
+
+// RecordSuccess は取得の成功を記録し、連続失敗回数と除外期間をリセットする
+func (m *FeedHealthMonitor) RecordSuccess(url string, itemCount int) {
+	state, _ := m.stateStore.Get(url)
+	state.URL = url
+	state.ConsecutiveFailures = 0
+	state.QuarantinedUntil = time.Time{}
+	state.LastSuccessAt = m.now()
+	state.LastItemCount = itemCount
+	m.update(state)
+}
+
+// RecordFailure は取得の失敗を記録する
+// 連続失敗回数がしきい値に達した場合は、除外期間を設定する
+func (m *FeedHealthMonitor) RecordFailure(url string, fetchErr error) {
+	now := m.now()
+	state, _ := m.stateStore.Get(url)
+	state.URL = url
+	state.ConsecutiveFailures++
+	state.LastError = fetchErr.Error()
+	state.LastFailureAt = now
+	if m.quarantine && state.ConsecutiveFailures >= m.failureThreshold {
+		state.QuarantinedUntil = now.Add(m.cooldown)
+		slog.Warn("Feed quarantined after consecutive failures",
+			"url", url,
+			"consecutive_failures", state.ConsecutiveFailures,
+			"quarantined_until", state.QuarantinedUntil)
+	}
+	m.update(state)
+}
+
+// Health は指定したフィードの健全性を返す
+func (m *FeedHealthMonitor) Health(feed entity.Feed) FeedHealth {
+	state, ok := m.stateStore.Get(feed.URL)
+	if !ok {
+		return FeedHealth{Feed: feed, State: FeedState{URL: feed.URL}, Status: FeedHealthStatusUnknown}
+	}
+	return FeedHealth{Feed: feed, State: state, Status: m.status(state)}
+}
+
+// FilterAvailable は除外期間中のフィードを取り除いたフィードと、除外したフィードを返す
+func (m *FeedHealthMonitor) FilterAvailable(feeds []entity.Feed) (available []entity.Feed, quarantined []FeedHealth) {
+	for _, feed := range feeds {
+		health := m.Health(feed)
+		if health.Status == FeedHealthStatusQuarantined {
+			quarantined = append(quarantined, health)
+			continue
+		}
+		available = append(available, feed)
+	}
+	return available, quarantined
+}
+
+// status はフィードの状態から健全性を判定する
+func (m *FeedHealthMonitor) status(state FeedState) FeedHealthStatus {
+	switch {
+	case m.quarantine && m.now().Before(state.QuarantinedUntil):
+		return FeedHealthStatusQuarantined
+	case state.ConsecutiveFailures > 0:
+		return FeedHealthStatusFailing
+	case state.LastSuccessAt.IsZero():
+		return FeedHealthStatusUnknown
+	default:
+		return FeedHealthStatusOK
+	}
+}
+
+// update は状態を保存する（保存に失敗しても取得処理は継続する）
+func (m *FeedHealthMonitor) update(state FeedState) {
+	if err := m.stateStore.Update(state); err != nil {
+		slog.Warn("Failed to save feed health", "url", state.URL, "error", err)
+	}
+}
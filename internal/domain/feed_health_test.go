@@ -0,0 +1,158 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestFeedHealthMonitor(store FeedStateStore, config *entity.FeedHealthConfig, now time.Time) *FeedHealthMonitor {
+	monitor := NewFeedHealthMonitor(store, config)
+	monitor.now = func() time.Time { return now }
+	return monitor
+}
+
+func TestFeedHealthMonitor_RecordFailure(t *testing.T) {
+	const url = "https://example.com/feed.xml"
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	fetchErr := errors.New("404 Not Found")
+
+	t.Run("しきい値に達するまでは除外しない", func(t *testing.T) {
+		store := newMemoryFeedStateStore()
+		monitor := newTestFeedHealthMonitor(store, &entity.FeedHealthConfig{FailureThreshold: 3}, now)
+
+		monitor.RecordFailure(url, fetchErr)
+		monitor.RecordFailure(url, fetchErr)
+
+		state := store.states[url]
+		assert.Equal(t, 2, state.ConsecutiveFailures)
+		assert.Equal(t, "404 Not Found", state.LastError)
+		assert.Equal(t, now, state.LastFailureAt)
+		assert.True(t, state.QuarantinedUntil.IsZero())
+		assert.Equal(t, FeedHealthStatusFailing, monitor.Health(entity.Feed{URL: url}).Status)
+	})
+
+	t.Run("しきい値に達すると除外期間を設定する", func(t *testing.T) {
+		store := newMemoryFeedStateStore()
+		monitor := newTestFeedHealthMonitor(store, &entity.FeedHealthConfig{FailureThreshold: 2, Cooldown: 6 * time.Hour}, now)
+
+		monitor.RecordFailure(url, fetchErr)
+		monitor.RecordFailure(url, fetchErr)
+
+		assert.Equal(t, now.Add(6*time.Hour), store.states[url].QuarantinedUntil)
+		assert.Equal(t, FeedHealthStatusQuarantined, monitor.Health(entity.Feed{URL: url}).Status)
+	})
+
+	t.Run("自動除外が無効な場合は失敗のみ記録する", func(t *testing.T) {
+		store := newMemoryFeedStateStore()
+		disabled := false
+		monitor := newTestFeedHealthMonitor(store, &entity.FeedHealthConfig{Enabled: &disabled, FailureThreshold: 1}, now)
+
+		monitor.RecordFailure(url, fetchErr)
+
+		assert.Equal(t, 1, store.states[url].ConsecutiveFailures)
+		assert.True(t, store.states[url].QuarantinedUntil.IsZero())
+	})
+
+	t.Run("既存の取得状態を保持する", func(t *testing.T) {
+		store := newMemoryFeedStateStore()
+		store.states[url] = FeedState{URL: url, ETag: `"abc"`}
+		monitor := newTestFeedHealthMonitor(store, nil, now)
+
+		monitor.RecordFailure(url, fetchErr)
+
+		assert.Equal(t, `"abc"`, store.states[url].ETag)
+	})
+}
+
+func TestFeedHealthMonitor_RecordSuccess(t *testing.T) {
+	const url = "https://example.com/feed.xml"
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	store := newMemoryFeedStateStore()
+	store.states[url] = FeedState{
+		URL:                 url,
+		ETag:                `"abc"`,
+		ConsecutiveFailures: 5,
+		LastError:           "timeout",
+		QuarantinedUntil:    now.Add(-time.Hour),
+	}
+	monitor := newTestFeedHealthMonitor(store, nil, now)
+
+	monitor.RecordSuccess(url, 12)
+
+	state := store.states[url]
+	assert.Equal(t, 0, state.ConsecutiveFailures)
+	assert.True(t, state.QuarantinedUntil.IsZero())
+	assert.Equal(t, now, state.LastSuccessAt)
+	assert.Equal(t, 12, state.LastItemCount)
+	assert.Equal(t, "timeout", state.LastError, "最後のエラーは履歴として残す")
+	assert.Equal(t, `"abc"`, state.ETag)
+	assert.Equal(t, FeedHealthStatusOK, monitor.Health(entity.Feed{URL: url}).Status)
+}
+
+func TestFeedHealthMonitor_Health(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		state    *FeedState
+		config   *entity.FeedHealthConfig
+		expected FeedHealthStatus
+	}{
+		{name: "記録なし", state: nil, expected: FeedHealthStatusUnknown},
+		{name: "取得状態のみ", state: &FeedState{LastFetchedAt: now}, expected: FeedHealthStatusUnknown},
+		{name: "成功", state: &FeedState{LastSuccessAt: now}, expected: FeedHealthStatusOK},
+		{name: "失敗中", state: &FeedState{ConsecutiveFailures: 1}, expected: FeedHealthStatusFailing},
+		{name: "除外期間中", state: &FeedState{ConsecutiveFailures: 3, QuarantinedUntil: now.Add(time.Hour)}, expected: FeedHealthStatusQuarantined},
+		{name: "除外期間の終了後は再取得の対象", state: &FeedState{ConsecutiveFailures: 3, QuarantinedUntil: now.Add(-time.Hour)}, expected: FeedHealthStatusFailing},
+		{
+			name:     "自動除外が無効な場合は除外期間を無視する",
+			state:    &FeedState{ConsecutiveFailures: 3, QuarantinedUntil: now.Add(time.Hour)},
+			config:   &entity.FeedHealthConfig{Enabled: new(bool)},
+			expected: FeedHealthStatusFailing,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			const url = "https://example.com/feed.xml"
+			store := newMemoryFeedStateStore()
+			if tt.state != nil {
+				tt.state.URL = url
+				store.states[url] = *tt.state
+			}
+			monitor := newTestFeedHealthMonitor(store, tt.config, now)
+
+			assert.Equal(t, tt.expected, monitor.Health(entity.Feed{URL: url}).Status)
+		})
+	}
+}
+
+func TestFeedHealthMonitor_FilterAvailable(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	feeds := entity.NewFeedsFromURLs([]string{
+		"https://a.example.com/feed",
+		"https://b.example.com/feed",
+		"https://c.example.com/feed",
+	})
+
+	store := newMemoryFeedStateStore()
+	store.states["https://b.example.com/feed"] = FeedState{
+		URL:                 "https://b.example.com/feed",
+		ConsecutiveFailures: 3,
+		QuarantinedUntil:    now.Add(time.Hour),
+	}
+	monitor := newTestFeedHealthMonitor(store, nil, now)
+
+	available, quarantined := monitor.FilterAvailable(feeds)
+
+	assert.Equal(t, []entity.Feed{feeds[0], feeds[2]}, available)
+	require.Len(t, quarantined, 1)
+	assert.Equal(t, "https://b.example.com/feed", quarantined[0].Feed.URL)
+	assert.Equal(t, now.Add(time.Hour), quarantined[0].State.QuarantinedUntil)
+}
@@ -0,0 +1,166 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"time"
+
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+)
+
+// ErrNoFeedsToSelect は選択候補のフィードが存在しない場合のエラー
+var ErrNoFeedsToSelect = errors.New("no available feeds")
+
+// FeedSelector は候補のフィードから記事の取得対象を選択するインターフェース
+type FeedSelector interface {
+	// Select は候補のフィードから取得対象のフィードを選択する
+	// 候補が空の場合はErrNoFeedsToSelectを返す
+	Select(feeds []entity.Feed) ([]entity.Feed, error)
+}
+
+// NewFeedSelector はフィード選択戦略に対応するFeedSelectorを作成する
+// stateStoreはround_robin、historyはleast_recent、rngはrandomとweightedでのみ使用する
+func NewFeedSelector(strategy string, stateStore FeedStateStore, history RecommendCache, rng *rand.Rand) (FeedSelector, error) {
+	switch strategy {
+	case "", entity.FeedSelectionStrategyRandom:
+		return &RandomFeedSelector{rng: rng}, nil
+	case entity.FeedSelectionStrategyWeighted:
+		return &WeightedFeedSelector{rng: rng}, nil
+	case entity.FeedSelectionStrategyRoundRobin:
+		return NewRoundRobinFeedSelector(stateStore), nil
+	case entity.FeedSelectionStrategyLeastRecent:
+		return NewLeastRecentFeedSelector(history), nil
+	case entity.FeedSelectionStrategyAll:
+		return &AllFeedSelector{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported feed selection strategy: %s", strategy)
+	}
+}
+
+// RandomFeedSelector は候補のフィードから等確率でランダムに1つを選択する
+// rngがnilの場合はグローバルな乱数生成器を使う
+type RandomFeedSelector struct {
+	rng *rand.Rand
+}
+
+// Select は候補のフィードからランダムに1つを選択する
+func (s *RandomFeedSelector) Select(feeds []entity.Feed) ([]entity.Feed, error) {
+	if len(feeds) == 0 {
+		return nil, ErrNoFeedsToSelect
+	}
+	return []entity.Feed{feeds[randomIntN(s.rng, len(feeds))]}, nil
+}
+
+// WeightedFeedSelector はフィードごとの重みに比例した確率で1つを選択する
+// rngがnilの場合はグローバルな乱数生成器を使う
+type WeightedFeedSelector struct {
+	rng *rand.Rand
+}
+
+// Select は重み付きランダムで候補のフィードから1つを選択する
+// 重みが0のフィードはデフォルトの重み（entity.DefaultFeedWeight）として扱う
+func (s *WeightedFeedSelector) Select(feeds []entity.Feed) ([]entity.Feed, error) {
+	if len(feeds) == 0 {
+		return nil, ErrNoFeedsToSelect
+	}
+
+	var total float64
+	for i := range feeds {
+		total += feeds[i].SelectionWeight()
+	}
+
+	threshold := randomFloat64(s.rng) * total
+	for i := range feeds {
+		threshold -= feeds[i].SelectionWeight()
+		if threshold < 0 {
+			return []entity.Feed{feeds[i]}, nil
+		}
+	}
+	// 浮動小数点の誤差で閾値が残った場合は最後のフィードを選択する
+	return []entity.Feed{feeds[len(feeds)-1]}, nil
+}
+
+// RoundRobinFeedSelector は実行をまたいでフィードを順番に1つずつ選択する
+// 各フィードを最後に選択した日時をFeedStateStoreに保存し、最も長く選択されていないフィードを選ぶ
+type RoundRobinFeedSelector struct {
+	stateStore FeedStateStore
+	now        func() time.Time
+}
+
+// NewRoundRobinFeedSelector はRoundRobinFeedSelectorを作成する
+func NewRoundRobinFeedSelector(stateStore FeedStateStore) *RoundRobinFeedSelector {
+	return &RoundRobinFeedSelector{
+		stateStore: stateStore,
+		now:        time.Now,
+	}
+}
+
+// Select は最も長く選択されていないフィードを選択し、選択日時を記録する
+// 一度も選択されていないフィードを優先し、同じ条件のフィードは候補の並び順で選ぶ
+func (s *RoundRobinFeedSelector) Select(feeds []entity.Feed) ([]entity.Feed, error) {
+	if len(feeds) == 0 {
+		return nil, ErrNoFeedsToSelect
+	}
+
+	selected := feeds[0]
+	selectedState, _ := s.stateStore.Get(selected.URL)
+	for _, feed := range feeds[1:] {
+		state, _ := s.stateStore.Get(feed.URL)
+		if state.LastSelectedAt.Before(selectedState.LastSelectedAt) {
+			selected = feed
+			selectedState = state
+		}
+	}
+
+	selectedState.URL = selected.URL
+	selectedState.LastSelectedAt = s.now()
+	if err := s.stateStore.Update(selectedState); err != nil {
+		// 選択位置を保存できなくても今回の選択は有効とする
+		slog.Warn("Failed to save feed selection state", "url", selected.URL, "error", err)
+	}
+
+	return []entity.Feed{selected}, nil
+}
+
+// LeastRecentFeedSelector は推薦履歴上で最も長く推薦されていないフィードを選択する
+type LeastRecentFeedSelector struct {
+	history RecommendCache
+}
+
+// NewLeastRecentFeedSelector はLeastRecentFeedSelectorを作成する
+func NewLeastRecentFeedSelector(history RecommendCache) *LeastRecentFeedSelector {
+	return &LeastRecentFeedSelector{history: history}
+}
+
+// Select は推薦履歴上で最も長く推薦されていないフィードを選択する
+// 一度も推薦されていないフィードを優先し、同じ条件のフィードは候補の並び順で選ぶ
+func (s *LeastRecentFeedSelector) Select(feeds []entity.Feed) ([]entity.Feed, error) {
+	if len(feeds) == 0 {
+		return nil, ErrNoFeedsToSelect
+	}
+
+	selected := feeds[0]
+	selectedAt, _ := s.history.LastRecommendedAt(selected.URL)
+	for _, feed := range feeds[1:] {
+		recommendedAt, _ := s.history.LastRecommendedAt(feed.URL)
+		if recommendedAt.Before(selectedAt) {
+			selected = feed
+			selectedAt = recommendedAt
+		}
+	}
+
+	return []entity.Feed{selected}, nil
+}
+
+// AllFeedSelector はすべての候補フィードを選択する
+type AllFeedSelector struct{}
+
+// Select は候補のフィードをすべて返す
+func (s *AllFeedSelector) Select(feeds []entity.Feed) ([]entity.Feed, error) {
+	if len(feeds) == 0 {
+		return nil, ErrNoFeedsToSelect
+	}
+	return feeds, nil
+}
@@ -0,0 +1,271 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryFeedStateStore は、テスト用のメモリ上のFeedStateStoreの実装
+type memoryFeedStateStore struct {
+	states map[string]FeedState
+}
+
+func newMemoryFeedStateStore() *memoryFeedStateStore {
+	return &memoryFeedStateStore{states: make(map[string]FeedState)}
+}
+
+func (s *memoryFeedStateStore) Initialize() error { return nil }
+func (s *memoryFeedStateStore) Close() error      { return nil }
+
+func (s *memoryFeedStateStore) Get(url string) (FeedState, bool) {
+	state, ok := s.states[url]
+	return state, ok
+}
+
+func (s *memoryFeedStateStore) Update(state FeedState) error {
+	s.states[state.URL] = state
+	return nil
+}
+
+// historyStub は、テスト用の推薦履歴を返すRecommendCacheの実装
+type historyStub struct {
+	lastRecommendedAt map[string]time.Time
+	entries           []RecommendEntry
+}
+
+func (h *historyStub) Initialize() error                     { return nil }
+func (h *historyStub) IsCached(url string) bool              { return false }
+func (h *historyStub) AddEntry(article entity.Article) error { return nil }
+func (h *historyStub) Close() error                          { return nil }
+
+func (h *historyStub) LastRecommendedAt(feedURL string) (time.Time, bool) {
+	at, ok := h.lastRecommendedAt[feedURL]
+	return at, ok
+}
+
+func (h *historyStub) RecentEntries(since time.Time) []RecommendEntry {
+	var recent []RecommendEntry
+	for _, entry := range h.entries {
+		if !entry.PostedAt.Before(since) {
+			recent = append(recent, entry)
+		}
+	}
+	return recent
+}
+
+func TestNewFeedSelector(t *testing.T) {
+	tests := []struct {
+		name        string
+		strategy    string
+		expected    FeedSelector
+		expectError bool
+	}{
+		{name: "未指定はrandom", strategy: "", expected: &RandomFeedSelector{}},
+		{name: "random", strategy: entity.FeedSelectionStrategyRandom, expected: &RandomFeedSelector{}},
+		{name: "weighted", strategy: entity.FeedSelectionStrategyWeighted, expected: &WeightedFeedSelector{}},
+		{name: "round_robin", strategy: entity.FeedSelectionStrategyRoundRobin, expected: &RoundRobinFeedSelector{}},
+		{name: "least_recent", strategy: entity.FeedSelectionStrategyLeastRecent, expected: &LeastRecentFeedSelector{}},
+		{name: "all", strategy: entity.FeedSelectionStrategyAll, expected: &AllFeedSelector{}},
+		{name: "未対応の戦略", strategy: "newest", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selector, err := NewFeedSelector(tt.strategy, newMemoryFeedStateStore(), &historyStub{}, nil)
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.IsType(t, tt.expected, selector)
+		})
+	}
+}
+
+func TestFeedSelector_EmptyFeeds(t *testing.T) {
+	selectors := map[string]FeedSelector{
+		"random":       &RandomFeedSelector{},
+		"weighted":     &WeightedFeedSelector{},
+		"round_robin":  NewRoundRobinFeedSelector(newMemoryFeedStateStore()),
+		"least_recent": NewLeastRecentFeedSelector(&historyStub{}),
+		"all":          &AllFeedSelector{},
+	}
+
+	for name, selector := range selectors {
+		t.Run(name, func(t *testing.T) {
+			_, err := selector.Select(nil)
+			assert.ErrorIs(t, err, ErrNoFeedsToSelect)
+		})
+	}
+}
+
+func TestFeedSelector_SameSeed(t *testing.T) {
+	feeds := []entity.Feed{
+		{URL: "https://a.example.com/feed", Weight: 1},
+		{URL: "https://b.example.com/feed", Weight: 2},
+		{URL: "https://c.example.com/feed", Weight: 3},
+	}
+
+	for _, strategy := range []string{entity.FeedSelectionStrategyRandom, entity.FeedSelectionStrategyWeighted} {
+		t.Run(strategy, func(t *testing.T) {
+			selectURLs := func(seed uint64) []string {
+				selector, err := NewFeedSelector(strategy, newMemoryFeedStateStore(), &historyStub{}, NewRandom(seed))
+				require.NoError(t, err)
+				var urls []string
+				for range 20 {
+					selected, err := selector.Select(feeds)
+					require.NoError(t, err)
+					urls = append(urls, selected[0].URL)
+				}
+				return urls
+			}
+			// 同じシードからは同じ順にフィードを選択する
+			assert.Equal(t, selectURLs(1), selectURLs(1))
+			assert.NotEqual(t, selectURLs(1), selectURLs(2))
+		})
+	}
+}
+
+func TestWeightedFeedSelector_Select(t *testing.T) {
+	feeds := []entity.Feed{
+		{URL: "https://heavy.example.com/feed", Weight: 9},
+		{URL: "https://light.example.com/feed", Weight: 1},
+	}
+	selector := &WeightedFeedSelector{}
+
+	counts := make(map[string]int)
+	const trials = 10000
+	for range trials {
+		selected, err := selector.Select(feeds)
+		require.NoError(t, err)
+		require.Len(t, selected, 1)
+		counts[selected[0].URL]++
+	}
+
+	// 重み9:1に対して十分な余裕を持った範囲で確認する
+	heavyRatio := float64(counts["https://heavy.example.com/feed"]) / trials
+	assert.InDelta(t, 0.9, heavyRatio, 0.05)
+	assert.Positive(t, counts["https://light.example.com/feed"])
+}
+
+func TestRoundRobinFeedSelector_Select(t *testing.T) {
+	feeds := entity.NewFeedsFromURLs([]string{
+		"https://a.example.com/feed",
+		"https://b.example.com/feed",
+		"https://c.example.com/feed",
+	})
+
+	t.Run("実行をまたいで順番に選択する", func(t *testing.T) {
+		store := newMemoryFeedStateStore()
+		now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		var selectedURLs []string
+		for range 4 {
+			// 実行ごとにセレクターを作り直しても、保存された状態から順番が続くことを確認する
+			selector := NewRoundRobinFeedSelector(store)
+			selector.now = func() time.Time { return now }
+			selected, err := selector.Select(feeds)
+			require.NoError(t, err)
+			require.Len(t, selected, 1)
+			selectedURLs = append(selectedURLs, selected[0].URL)
+			now = now.Add(time.Hour)
+		}
+
+		assert.Equal(t, []string{
+			"https://a.example.com/feed",
+			"https://b.example.com/feed",
+			"https://c.example.com/feed",
+			"https://a.example.com/feed",
+		}, selectedURLs)
+	})
+
+	t.Run("既存の取得状態を保持したまま選択日時を記録する", func(t *testing.T) {
+		store := newMemoryFeedStateStore()
+		store.states["https://a.example.com/feed"] = FeedState{
+			URL:            "https://a.example.com/feed",
+			ETag:           `"abc"`,
+			LastSelectedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		}
+		store.states["https://b.example.com/feed"] = FeedState{
+			URL:            "https://b.example.com/feed",
+			LastSelectedAt: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+		}
+		store.states["https://c.example.com/feed"] = FeedState{
+			URL:            "https://c.example.com/feed",
+			LastSelectedAt: time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+		}
+
+		selectedAt := time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC)
+		selector := NewRoundRobinFeedSelector(store)
+		selector.now = func() time.Time { return selectedAt }
+
+		selected, err := selector.Select(feeds)
+		require.NoError(t, err)
+		assert.Equal(t, "https://a.example.com/feed", selected[0].URL)
+		assert.Equal(t, `"abc"`, store.states["https://a.example.com/feed"].ETag)
+		assert.Equal(t, selectedAt, store.states["https://a.example.com/feed"].LastSelectedAt)
+	})
+}
+
+func TestLeastRecentFeedSelector_Select(t *testing.T) {
+	feeds := entity.NewFeedsFromURLs([]string{
+		"https://a.example.com/feed",
+		"https://b.example.com/feed",
+		"https://c.example.com/feed",
+	})
+	now := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name              string
+		lastRecommendedAt map[string]time.Time
+		expectedURL       string
+	}{
+		{
+			name: "最も長く推薦されていないフィードを選択する",
+			lastRecommendedAt: map[string]time.Time{
+				"https://a.example.com/feed": now.Add(-1 * time.Hour),
+				"https://b.example.com/feed": now.Add(-72 * time.Hour),
+				"https://c.example.com/feed": now.Add(-24 * time.Hour),
+			},
+			expectedURL: "https://b.example.com/feed",
+		},
+		{
+			name: "推薦履歴のないフィードを優先する",
+			lastRecommendedAt: map[string]time.Time{
+				"https://a.example.com/feed": now.Add(-72 * time.Hour),
+				"https://b.example.com/feed": now.Add(-24 * time.Hour),
+			},
+			expectedURL: "https://c.example.com/feed",
+		},
+		{
+			name:              "履歴がない場合は先頭のフィードを選択する",
+			lastRecommendedAt: map[string]time.Time{},
+			expectedURL:       "https://a.example.com/feed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selector := NewLeastRecentFeedSelector(&historyStub{lastRecommendedAt: tt.lastRecommendedAt})
+			selected, err := selector.Select(feeds)
+			require.NoError(t, err)
+			require.Len(t, selected, 1)
+			assert.Equal(t, tt.expectedURL, selected[0].URL)
+		})
+	}
+}
+
+func TestAllFeedSelector_Select(t *testing.T) {
+	feeds := entity.NewFeedsFromURLs([]string{
+		"https://a.example.com/feed",
+		"https://b.example.com/feed",
+	})
+
+	selected, err := (&AllFeedSelector{}).Select(feeds)
+	require.NoError(t, err)
+	assert.Equal(t, feeds, selected)
+}
@@ -0,0 +1,44 @@
+package domain
+
+import "time"
+
+// FeedState はフィードごとの条件付き取得（If-None-Match / If-Modified-Since）やフィード選択、健全性の記録に必要な状態を表す
+type FeedState struct {
+	URL           string    `json:"url"`
+	ETag          string    `json:"etag,omitempty"`
+	LastModified  string    `json:"last_modified,omitempty"`
+	LastFetchedAt time.Time `json:"last_fetched_at"`
+	// Body は最後に取得に成功したときのフィードの本文（304 Not Modifiedの場合に記事一覧を復元するために使う）
+	Body []byte `json:"body,omitempty"`
+	// LastSelectedAt はフィード選択戦略（round_robin）で最後に選択された日時
+	LastSelectedAt time.Time `json:"last_selected_at,omitzero"`
+
+	// ConsecutiveFailures は連続して取得に失敗した回数（成功すると0に戻る）
+	ConsecutiveFailures int `json:"consecutive_failures,omitempty"`
+	// LastError は最後に取得に失敗したときのエラーメッセージ
+	LastError string `json:"last_error,omitempty"`
+	// LastFailureAt は最後に取得に失敗した日時
+	LastFailureAt time.Time `json:"last_failure_at,omitzero"`
+	// LastSuccessAt は最後に取得に成功した日時
+	LastSuccessAt time.Time `json:"last_success_at,omitzero"`
+	// LastItemCount は最後に取得に成功したときの記事数
+	LastItemCount int `json:"last_item_count,omitempty"`
+	// QuarantinedUntil は連続失敗により取得対象から外している期限
+	QuarantinedUntil time.Time `json:"quarantined_until,omitzero"`
+}
+
+// FeedStateStore はフィードごとの取得状態を永続化するためのインターフェース
+// 複数のフィードを並行に取得するため、実装はgoroutine安全である必要がある
+type FeedStateStore interface {
+	// Initialize は保存済みの状態を読み込む
+	Initialize() error
+
+	// Get は指定したフィードURLの状態を返す（存在しない場合はfalse）
+	Get(url string) (FeedState, bool)
+
+	// Update は指定したフィードの状態を更新する
+	Update(state FeedState) error
+
+	// Close は未保存の状態を書き出してリソースを解放する
+	Close() error
+}
@@ -1,46 +1,160 @@
 package domain
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"log/slog"
 	"sort"
+	"sync"
+	"time"
 
 	"github.com/canpok1/ai-feed/internal/domain/entity"
 )
 
+const (
+	// DefaultFetchConcurrency は同時に取得するフィード数のデフォルト値
+	DefaultFetchConcurrency = 4
+	// DefaultFeedTimeout は1フィードあたりの取得タイムアウトのデフォルト値
+	DefaultFeedTimeout = 30 * time.Second
+	// DefaultFetchDeadline はフィード取得全体のタイムアウトのデフォルト値
+	DefaultFetchDeadline = 2 * time.Minute
+)
+
 type FetchClient interface {
-	Fetch(url string) ([]entity.Article, error)
+	Fetch(ctx context.Context, feed entity.Feed) ([]entity.Article, error)
+}
+
+// FetcherOptions はFetcherの並行取得に関する設定
+type FetcherOptions struct {
+	// Concurrency は同時に取得するフィードの最大数（1未満の場合は1として扱う）
+	Concurrency int
+	// FeedTimeout は1フィードあたりの取得タイムアウト（0以下の場合は無制限）
+	FeedTimeout time.Duration
+	// Deadline はフィード取得全体のタイムアウト（0以下の場合は無制限）
+	Deadline time.Duration
+	// HealthMonitor はフィードごとの取得結果を記録する（nilの場合は記録しない）
+	HealthMonitor *FeedHealthMonitor
+}
+
+// DefaultFetcherOptions はデフォルトのFetcherOptionsを返す
+func DefaultFetcherOptions() FetcherOptions {
+	return FetcherOptions{
+		Concurrency: DefaultFetchConcurrency,
+		FeedTimeout: DefaultFeedTimeout,
+		Deadline:    DefaultFetchDeadline,
+	}
+}
+
+// NewFetcherOptions はフィード取得設定の同時取得数とタイムアウトを反映したFetcherOptionsを返す
+// 設定されていない項目はデフォルト値を使う
+func NewFetcherOptions(config *entity.FetchConfig) FetcherOptions {
+	options := DefaultFetcherOptions()
+	if config == nil {
+		return options
+	}
+	if config.Concurrency > 0 {
+		options.Concurrency = config.Concurrency
+	}
+	if config.Timeout > 0 {
+		options.FeedTimeout = config.Timeout
+	}
+	if config.Deadline > 0 {
+		options.Deadline = config.Deadline
+	}
+	return options
 }
 
 type Fetcher struct {
 	client        FetchClient
 	errorCallback ErrorCallback
+	options       FetcherOptions
 }
 
 type ErrorCallback func(string, error) error
 
 func NewFetcher(client FetchClient, errorCallback ErrorCallback) *Fetcher {
+	return NewFetcherWithOptions(client, errorCallback, DefaultFetcherOptions())
+}
+
+// NewFetcherWithOptions は並行取得の設定を指定してFetcherを作成する
+func NewFetcherWithOptions(client FetchClient, errorCallback ErrorCallback, options FetcherOptions) *Fetcher {
+	if options.Concurrency < 1 {
+		options.Concurrency = 1
+	}
 	return &Fetcher{
 		client:        client,
 		errorCallback: errorCallback,
+		options:       options,
 	}
 }
 
-func (f *Fetcher) Fetch(urls []string, limit int) ([]entity.Article, error) {
-	var allArticles []entity.Article
-	for _, url := range urls {
-		articles, err := f.client.Fetch(url)
-		if err != nil {
-			if err := f.errorCallback(url, err); err != nil {
-				return nil, err
+// Fetch は複数のフィードをワーカープールで並行に取得し、公開日時の新しい順にマージして返す
+// 取得に失敗したフィードはErrorCallbackに渡され、コールバックがエラーを返した場合は残りの取得を中断する
+func (f *Fetcher) Fetch(ctx context.Context, feeds []entity.Feed, limit int) ([]entity.Article, error) {
+	fetchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	if f.options.Deadline > 0 {
+		var cancelDeadline context.CancelFunc
+		fetchCtx, cancelDeadline = context.WithTimeout(fetchCtx, f.options.Deadline)
+		defer cancelDeadline()
+	}
+
+	results := make([][]entity.Article, len(feeds))
+	skipped := make([]bool, len(feeds))
+
+	var (
+		mu       sync.Mutex
+		fatalErr error
+	)
+
+	f.forEachFeed(len(feeds), func(i int) {
+		url := feeds[i].URL
+		articles, err := f.fetchOne(fetchCtx, feeds[i])
+		if err == nil {
+			slog.Debug("記事を取得しました", "feed_url", url, "article_count", len(articles))
+			results[i] = attachFeedInfo(articles, feeds[i])
+			f.recordSuccess(url, len(articles))
+			return
+		}
+
+		// 呼び出し元のキャンセル時はコールバックを呼ばずに中断する
+		if ctx.Err() != nil {
+			return
+		}
+		// 全体のタイムアウトや他のフィードのエラーによる中断はフィード自体の失敗として記録しない
+		if fetchCtx.Err() == nil {
+			f.recordFailure(url, err)
+		} else if errors.Is(fetchCtx.Err(), context.DeadlineExceeded) {
+			skipped[i] = true
+		}
+
+		// コールバックは直列に呼び出す
+		mu.Lock()
+		if fatalErr == nil {
+			if cbErr := f.errorCallback(url, err); cbErr != nil {
+				fatalErr = cbErr
+				cancel()
 			}
-			continue
 		}
+		mu.Unlock()
+	})
 
-		slog.Debug("記事を取得しました", "feed_url", url, "article_count", len(articles))
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("feed fetch interrupted: %w", err)
+	}
+	if fatalErr != nil {
+		return nil, fatalErr
+	}
+	f.warnSkippedFeeds(feeds, skipped)
+
+	// 入力順にマージしてから安定ソートすることで、同時刻の記事の順序を決定的にする
+	var allArticles []entity.Article
+	for _, articles := range results {
 		allArticles = append(allArticles, articles...)
 	}
 
-	sort.Slice(allArticles, func(i, j int) bool {
+	sort.SliceStable(allArticles, func(i, j int) bool {
 		if allArticles[i].Published == nil {
 			return false
 		}
@@ -56,3 +170,83 @@ func (f *Fetcher) Fetch(urls []string, limit int) ([]entity.Article, error) {
 
 	return allArticles, nil
 }
+
+// warnSkippedFeeds は全体のタイムアウトにより取得できなかったフィードを警告として出力する
+func (f *Fetcher) warnSkippedFeeds(feeds []entity.Feed, skipped []bool) {
+	var skippedURLs []string
+	for i, feed := range feeds {
+		if skipped[i] {
+			skippedURLs = append(skippedURLs, feed.URL)
+		}
+	}
+	if len(skippedURLs) > 0 {
+		slog.Warn("Feeds skipped because the fetch deadline was exceeded (increase fetch.deadline to fetch them)",
+			"deadline", f.options.Deadline,
+			"skipped_count", len(skippedURLs),
+			"skipped_urls", skippedURLs)
+	}
+}
+
+// forEachFeed はConcurrency個のワーカーでfnを0からcount-1まで呼び出し、すべて終わるまで待つ
+func (f *Fetcher) forEachFeed(count int, fn func(i int)) {
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	for range min(f.options.Concurrency, count) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				fn(i)
+			}
+		}()
+	}
+
+	for i := range count {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+}
+
+// attachFeedInfo は取得元フィードの情報を設定した記事のコピーを返す
+// FetchClientが返したスライスは他のワーカーと共有されることがあるため変更しない
+func attachFeedInfo(articles []entity.Article, feed entity.Feed) []entity.Article {
+	attached := make([]entity.Article, len(articles))
+	for i, article := range articles {
+		if article.FeedURL == "" {
+			article.FeedURL = feed.URL
+		}
+		article.FeedName = feed.DisplayName()
+		article.FeedTags = feed.Tags
+		attached[i] = article
+	}
+	return attached
+}
+
+// recordSuccess はHealthMonitorが設定されている場合に取得の成功を記録する
+func (f *Fetcher) recordSuccess(url string, itemCount int) {
+	if f.options.HealthMonitor != nil {
+		f.options.HealthMonitor.RecordSuccess(url, itemCount)
+	}
+}
+
+// recordFailure はHealthMonitorが設定されている場合に取得の失敗を記録する
+func (f *Fetcher) recordFailure(url string, err error) {
+	if f.options.HealthMonitor != nil {
+		f.options.HealthMonitor.RecordFailure(url, err)
+	}
+}
+
+// fetchOne は1フィードあたりのタイムアウトを適用してフィードを取得する
+func (f *Fetcher) fetchOne(ctx context.Context, feed entity.Feed) ([]entity.Article, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if f.options.FeedTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, f.options.FeedTimeout)
+		defer cancel()
+	}
+	return f.client.Fetch(ctx, feed)
+}
@@ -1,8 +1,13 @@
 package domain
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"errors"
+	"log/slog"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -32,7 +37,8 @@ func (m *mockFetchClient) setError(url string, err error) {
 	m.errors[url] = err
 }
 
-func (m *mockFetchClient) Fetch(url string) ([]entity.Article, error) {
+func (m *mockFetchClient) Fetch(_ context.Context, feed entity.Feed) ([]entity.Article, error) {
+	url := feed.URL
 	if err, exists := m.errors[url]; exists {
 		return nil, err
 	}
@@ -68,7 +74,7 @@ func TestFetcherLogOutput(t *testing.T) {
 
 		// テスト実行
 		urls := []string{"https://example1.com/feed.xml", "https://example2.com/feed.xml"}
-		articles, err := fetcher.Fetch(urls, 0)
+		articles, err := fetcher.Fetch(context.Background(), entity.NewFeedsFromURLs(urls), 0)
 
 		// 結果の検証
 		assert.NoError(t, err)
@@ -101,7 +107,7 @@ func TestFetcherLogOutput(t *testing.T) {
 
 		// テスト実行
 		urls := []string{"https://example1.com/feed.xml", "https://example2.com/feed.xml"}
-		articles, err := fetcher.Fetch(urls, 0)
+		articles, err := fetcher.Fetch(context.Background(), entity.NewFeedsFromURLs(urls), 0)
 
 		// 結果の検証
 		assert.NoError(t, err)
@@ -140,7 +146,7 @@ func TestFetcherMultipleURLs(t *testing.T) {
 
 		// テスト実行
 		urls := []string{"https://example1.com/feed.xml", "https://example2.com/feed.xml"}
-		articles, err := fetcher.Fetch(urls, 0)
+		articles, err := fetcher.Fetch(context.Background(), entity.NewFeedsFromURLs(urls), 0)
 
 		// 結果の検証
 		require.NoError(t, err)
@@ -152,3 +158,279 @@ func TestFetcherMultipleURLs(t *testing.T) {
 		assert.Equal(t, "古い記事", articles[2].Title)
 	})
 }
+
+// blockingFetchClient はコンテキストが終了するまで応答しないテスト用のFetchClient
+type blockingFetchClient struct {
+	mu          sync.Mutex
+	running     int
+	maxRunning  int
+	slowURLs    map[string]bool
+	articles    []entity.Article
+	delay       time.Duration
+	calledCount int
+}
+
+func (c *blockingFetchClient) Fetch(ctx context.Context, feed entity.Feed) ([]entity.Article, error) {
+	url := feed.URL
+	c.mu.Lock()
+	c.running++
+	c.calledCount++
+	c.maxRunning = max(c.maxRunning, c.running)
+	c.mu.Unlock()
+	defer func() {
+		c.mu.Lock()
+		c.running--
+		c.mu.Unlock()
+	}()
+
+	if c.slowURLs[url] {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+
+	select {
+	case <-time.After(c.delay):
+		return c.articles, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func TestFetcher_Concurrency(t *testing.T) {
+	t.Run("同時取得数が上限を超えない", func(t *testing.T) {
+		now := time.Now()
+		client := &blockingFetchClient{
+			delay:    20 * time.Millisecond,
+			articles: []entity.Article{{Title: "記事", Link: "https://example.com/a", Published: &now}},
+		}
+		fetcher := NewFetcherWithOptions(client, func(url string, err error) error { return err }, FetcherOptions{
+			Concurrency: 2,
+			FeedTimeout: time.Second,
+			Deadline:    5 * time.Second,
+		})
+
+		urls := []string{"u1", "u2", "u3", "u4", "u5"}
+		articles, err := fetcher.Fetch(context.Background(), entity.NewFeedsFromURLs(urls), 0)
+
+		require.NoError(t, err)
+		assert.Len(t, articles, 5)
+		assert.Equal(t, 5, client.calledCount)
+		assert.LessOrEqual(t, client.maxRunning, 2)
+		// 複数のワーカーが共有するFetchClientのスライスは変更しない
+		assert.Empty(t, client.articles[0].FeedURL)
+	})
+
+	t.Run("Concurrencyが0以下の場合は1として扱う", func(t *testing.T) {
+		fetcher := NewFetcherWithOptions(newMockFetchClient(), nil, FetcherOptions{Concurrency: 0})
+		assert.Equal(t, 1, fetcher.options.Concurrency)
+	})
+}
+
+func TestFetcher_Timeouts(t *testing.T) {
+	t.Run("フィード単位のタイムアウトはErrorCallbackに渡され、他のフィードは取得される", func(t *testing.T) {
+		now := time.Now()
+		client := &blockingFetchClient{
+			slowURLs: map[string]bool{"slow": true},
+			articles: []entity.Article{{Title: "記事", Link: "https://example.com/a", Published: &now}},
+		}
+
+		var mu sync.Mutex
+		var failedURLs []string
+		fetcher := NewFetcherWithOptions(client, func(url string, err error) error {
+			mu.Lock()
+			defer mu.Unlock()
+			assert.ErrorIs(t, err, context.DeadlineExceeded)
+			failedURLs = append(failedURLs, url)
+			return nil
+		}, FetcherOptions{Concurrency: 2, FeedTimeout: 20 * time.Millisecond})
+
+		articles, err := fetcher.Fetch(context.Background(), entity.NewFeedsFromURLs([]string{"slow", "fast"}), 0)
+
+		require.NoError(t, err)
+		assert.Len(t, articles, 1)
+		assert.Equal(t, []string{"slow"}, failedURLs)
+	})
+
+	t.Run("全体のタイムアウトを超えたフィードはErrorCallbackに渡される", func(t *testing.T) {
+		client := &blockingFetchClient{slowURLs: map[string]bool{"slow1": true, "slow2": true}}
+
+		var mu sync.Mutex
+		callbackCount := 0
+		fetcher := NewFetcherWithOptions(client, func(url string, err error) error {
+			mu.Lock()
+			defer mu.Unlock()
+			callbackCount++
+			return nil
+		}, FetcherOptions{Concurrency: 1, Deadline: 20 * time.Millisecond})
+
+		articles, err := fetcher.Fetch(context.Background(), entity.NewFeedsFromURLs([]string{"slow1", "slow2"}), 0)
+
+		require.NoError(t, err)
+		assert.Empty(t, articles)
+		assert.Equal(t, 2, callbackCount)
+	})
+
+	t.Run("全体のタイムアウトで取得できなかったフィードを警告に出力する", func(t *testing.T) {
+		var logBuffer bytes.Buffer
+		originalLogger := slog.Default()
+		slog.SetDefault(slog.New(slog.NewJSONHandler(&logBuffer, &slog.HandlerOptions{Level: slog.LevelWarn})))
+		defer slog.SetDefault(originalLogger)
+
+		client := &blockingFetchClient{
+			slowURLs: map[string]bool{"slow1": true, "slow2": true},
+			articles: []entity.Article{{Title: "fast"}},
+		}
+		fetcher := NewFetcherWithOptions(client, func(url string, err error) error {
+			return nil
+		}, FetcherOptions{Concurrency: 3, Deadline: 20 * time.Millisecond})
+
+		articles, err := fetcher.Fetch(context.Background(), entity.NewFeedsFromURLs([]string{"slow1", "fast", "slow2"}), 0)
+
+		require.NoError(t, err)
+		assert.Len(t, articles, 1)
+
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal(logBuffer.Bytes(), &entry))
+		assert.Equal(t, "WARN", entry["level"])
+		assert.Equal(t, float64(2), entry["skipped_count"])
+		assert.Equal(t, []any{"slow1", "slow2"}, entry["skipped_urls"])
+	})
+}
+
+func TestNewFetcherOptions(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   *entity.FetchConfig
+		expected FetcherOptions
+	}{
+		{
+			name:     "nilの場合はデフォルト値",
+			config:   nil,
+			expected: DefaultFetcherOptions(),
+		},
+		{
+			name:     "未設定の項目はデフォルト値",
+			config:   &entity.FetchConfig{Timeout: 10 * time.Second},
+			expected: FetcherOptions{Concurrency: DefaultFetchConcurrency, FeedTimeout: 10 * time.Second, Deadline: DefaultFetchDeadline},
+		},
+		{
+			name:     "全項目を設定",
+			config:   &entity.FetchConfig{Timeout: 10 * time.Second, Deadline: 5 * time.Minute, Concurrency: 8},
+			expected: FetcherOptions{Concurrency: 8, FeedTimeout: 10 * time.Second, Deadline: 5 * time.Minute},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NewFetcherOptions(tt.config))
+		})
+	}
+}
+
+func TestFetcher_Cancellation(t *testing.T) {
+	t.Run("呼び出し元のキャンセル時はエラーを返しErrorCallbackを呼ばない", func(t *testing.T) {
+		client := &blockingFetchClient{slowURLs: map[string]bool{"slow": true}}
+		callbackCalled := false
+		fetcher := NewFetcherWithOptions(client, func(url string, err error) error {
+			callbackCalled = true
+			return err
+		}, FetcherOptions{Concurrency: 1})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			time.Sleep(20 * time.Millisecond)
+			cancel()
+		}()
+
+		articles, err := fetcher.Fetch(ctx, entity.NewFeedsFromURLs([]string{"slow"}), 0)
+
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Nil(t, articles)
+		assert.False(t, callbackCalled)
+	})
+
+	t.Run("ErrorCallbackがエラーを返した場合は残りの取得を中断してエラーを返す", func(t *testing.T) {
+		mockClient := newMockFetchClient()
+		mockClient.setError("https://example1.com/feed.xml", errors.New("fetch error"))
+
+		callbackCount := 0
+		fetcher := NewFetcherWithOptions(mockClient, func(url string, err error) error {
+			callbackCount++
+			return err
+		}, FetcherOptions{Concurrency: 1})
+
+		articles, err := fetcher.Fetch(context.Background(), entity.NewFeedsFromURLs([]string{
+			"https://example1.com/feed.xml",
+			"https://example2.com/feed.xml",
+		}), 0)
+
+		assert.EqualError(t, err, "fetch error")
+		assert.Nil(t, articles)
+		assert.Equal(t, 1, callbackCount)
+	})
+}
+
+func TestFetcher_MergeOrder(t *testing.T) {
+	t.Run("公開日時が同じ記事はフィードの指定順を保つ", func(t *testing.T) {
+		mockClient := newMockFetchClient()
+		published := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+		mockClient.setResponse("feed1", []entity.Article{{Title: "A", Published: &published}})
+		mockClient.setResponse("feed2", []entity.Article{{Title: "B", Published: &published}})
+		mockClient.setResponse("feed3", []entity.Article{{Title: "C"}})
+
+		fetcher := NewFetcherWithOptions(mockClient, nil, FetcherOptions{Concurrency: 3})
+		articles, err := fetcher.Fetch(context.Background(), entity.NewFeedsFromURLs([]string{"feed3", "feed1", "feed2"}), 2)
+
+		require.NoError(t, err)
+		require.Len(t, articles, 2)
+		assert.Equal(t, "A", articles[0].Title)
+		assert.Equal(t, "B", articles[1].Title)
+	})
+}
+
+func TestFetcher_AttachFeedInfo(t *testing.T) {
+	mockClient := newMockFetchClient()
+	mockClient.setResponse("https://example.com/a.xml", []entity.Article{{Title: "A"}})
+	mockClient.setResponse("https://example.com/b.xml", []entity.Article{{Title: "B"}})
+
+	fetcher := NewFetcherWithOptions(mockClient, nil, FetcherOptions{Concurrency: 2})
+	articles, err := fetcher.Fetch(context.Background(), []entity.Feed{
+		{URL: "https://example.com/a.xml", Name: "Feed A", Tags: []string{"tech"}},
+		{URL: "https://example.com/b.xml"},
+	}, 0)
+
+	require.NoError(t, err)
+	require.Len(t, articles, 2)
+	assert.Equal(t, "https://example.com/a.xml", articles[0].FeedURL)
+	assert.Equal(t, "Feed A", articles[0].FeedName)
+	assert.Equal(t, []string{"tech"}, articles[0].FeedTags)
+	// 表示名が未設定の場合はURLを使用する
+	assert.Equal(t, "https://example.com/b.xml", articles[1].FeedName)
+}
+
+func TestFetcher_HealthMonitor(t *testing.T) {
+	mockClient := newMockFetchClient()
+	mockClient.setResponse("https://ok.example.com/feed", []entity.Article{{Title: "A"}, {Title: "B"}})
+	mockClient.setError("https://broken.example.com/feed", errors.New("404 Not Found"))
+
+	store := newMemoryFeedStateStore()
+	// テスト用のストアはgoroutine安全ではないため、1件ずつ取得する
+	fetcher := NewFetcherWithOptions(mockClient, func(url string, err error) error { return nil }, FetcherOptions{
+		Concurrency:   1,
+		HealthMonitor: NewFeedHealthMonitor(store, nil),
+	})
+
+	_, err := fetcher.Fetch(context.Background(), entity.NewFeedsFromURLs([]string{
+		"https://ok.example.com/feed",
+		"https://broken.example.com/feed",
+	}), 0)
+	require.NoError(t, err)
+
+	okState := store.states["https://ok.example.com/feed"]
+	assert.Equal(t, 2, okState.LastItemCount)
+	assert.False(t, okState.LastSuccessAt.IsZero())
+
+	brokenState := store.states["https://broken.example.com/feed"]
+	assert.Equal(t, 1, brokenState.ConsecutiveFailures)
+	assert.Equal(t, "404 Not Found", brokenState.LastError)
+}
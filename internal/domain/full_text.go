@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+)
+
+// ArticleExtractor は記事ページから読みやすい本文を抽出するインターフェース
+type ArticleExtractor interface {
+	// Extract は記事ページを取得し、本文をプレーンテキストで返す
+	Extract(ctx context.Context, articleURL string) (string, error)
+}
+
+// FullTextArticleSelector は選択した記事の本文が短い場合に、記事ページから取得した本文で置き換えるArticleSelector
+// 本文を取得するのは選択された1件のみのため、候補の記事数が多くても記事ページへのリクエストは1回で済む
+type FullTextArticleSelector struct {
+	selector  ArticleSelector
+	extractor ArticleExtractor
+	policy    fullTextPolicy
+}
+
+// fullTextPolicy は記事ページから本文を取得する対象の記事かどうかを判定する
+type fullTextPolicy struct {
+	config           *entity.FullTextConfig
+	feeds            map[string]entity.Feed
+	minContentLength int
+}
+
+// newFullTextPolicy はfullTextPolicyを作成する
+// feedsは記事の取得元フィードごとの設定（full_text）を参照するために使用する
+func newFullTextPolicy(config *entity.FullTextConfig, feeds []entity.Feed) fullTextPolicy {
+	feedMap := make(map[string]entity.Feed, len(feeds))
+	for _, feed := range feeds {
+		feedMap[feed.URL] = feed
+	}
+	return fullTextPolicy{
+		config:           config,
+		feeds:            feedMap,
+		minContentLength: config.MinContentLengthOrDefault(),
+	}
+}
+
+// needsFullText は記事ページから本文を取得する対象かどうかを返す
+func (p fullTextPolicy) needsFullText(article *entity.Article) bool {
+	feed, ok := p.feeds[article.FeedURL]
+	if !ok {
+		feed = entity.Feed{URL: article.FeedURL}
+	}
+	if !p.config.IsEnabledFor(feed) || article.Link == "" {
+		return false
+	}
+	return utf8.RuneCountInString(strings.TrimSpace(article.Content)) < p.minContentLength
+}
+
+// NewFullTextArticleSelector はFullTextArticleSelectorを作成する
+// feedsは記事の取得元フィードごとの設定（full_text）を参照するために使用する
+func NewFullTextArticleSelector(
+	selector ArticleSelector,
+	extractor ArticleExtractor,
+	config *entity.FullTextConfig,
+	feeds []entity.Feed,
+) *FullTextArticleSelector {
+	return &FullTextArticleSelector{
+		selector:  selector,
+		extractor: extractor,
+		policy:    newFullTextPolicy(config, feeds),
+	}
+}
+
+// Select は記事を選択し、必要に応じて本文を記事ページから取得した本文で置き換える
+// 本文の取得に失敗した場合は、フィードに含まれていた本文のまま返す
+func (s *FullTextArticleSelector) Select(ctx context.Context, articles []entity.Article) (*entity.Article, error) {
+	article, err := s.selector.Select(ctx, articles)
+	if err != nil || article == nil {
+		return article, err
+	}
+	if !s.policy.needsFullText(article) {
+		return article, nil
+	}
+
+	fullText, err := s.extractor.Extract(ctx, article.Link)
+	if err != nil {
+		slog.Warn("Failed to fetch full text, using feed content", "url", article.Link, "error", err)
+		return article, nil
+	}
+	if utf8.RuneCountInString(fullText) <= utf8.RuneCountInString(strings.TrimSpace(article.Content)) {
+		slog.Debug("Extracted text is not longer than feed content, using feed content", "url", article.Link)
+		return article, nil
+	}
+
+	slog.Info("Replaced article content with full text",
+		"url", article.Link,
+		"feed_content_length", utf8.RuneCountInString(article.Content),
+		"full_text_length", utf8.RuneCountInString(fullText))
+	enriched := *article
+	if enriched.FeedContent == "" {
+		enriched.FeedContent = article.Content
+	}
+	enriched.Content = fullText
+	return &enriched, nil
+}
@@ -0,0 +1,140 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"github.com/canpok1/ai-feed/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// extractorStub は、テスト用の本文を返すArticleExtractorの実装
+type extractorStub struct {
+	text  string
+	err   error
+	calls []string
+}
+
+func (e *extractorStub) Extract(ctx context.Context, articleURL string) (string, error) {
+	e.calls = append(e.calls, articleURL)
+	return e.text, e.err
+}
+
+func TestFullTextArticleSelector_Select(t *testing.T) {
+	const feedURL = "https://example.com/feed.xml"
+	fullText := strings.Repeat("記事ページの本文です。", 30)
+
+	tests := []struct {
+		name          string
+		config        *entity.FullTextConfig
+		feeds         []entity.Feed
+		content       string
+		extractor     *extractorStub
+		wantContent   string
+		wantExtracted bool
+	}{
+		{
+			name:          "本文が短い場合は記事ページの本文で置き換える",
+			config:        &entity.FullTextConfig{Enabled: testutil.BoolPtr(true)},
+			content:       "概要のみ",
+			extractor:     &extractorStub{text: fullText},
+			wantContent:   fullText,
+			wantExtracted: true,
+		},
+		{
+			name:          "本文がしきい値以上の場合は取得しない",
+			config:        &entity.FullTextConfig{Enabled: testutil.BoolPtr(true), MinContentLength: 5},
+			content:       "十分な長さの本文",
+			extractor:     &extractorStub{text: fullText},
+			wantContent:   "十分な長さの本文",
+			wantExtracted: false,
+		},
+		{
+			name:          "プロファイルで無効の場合は取得しない",
+			config:        nil,
+			content:       "概要のみ",
+			extractor:     &extractorStub{text: fullText},
+			wantContent:   "概要のみ",
+			wantExtracted: false,
+		},
+		{
+			name:          "フィードの設定がプロファイルの設定より優先される",
+			config:        nil,
+			feeds:         []entity.Feed{{URL: feedURL, FullText: testutil.BoolPtr(true)}},
+			content:       "概要のみ",
+			extractor:     &extractorStub{text: fullText},
+			wantContent:   fullText,
+			wantExtracted: true,
+		},
+		{
+			name:          "フィードで無効にした場合は取得しない",
+			config:        &entity.FullTextConfig{Enabled: testutil.BoolPtr(true)},
+			feeds:         []entity.Feed{{URL: feedURL, FullText: testutil.BoolPtr(false)}},
+			content:       "概要のみ",
+			extractor:     &extractorStub{text: fullText},
+			wantContent:   "概要のみ",
+			wantExtracted: false,
+		},
+		{
+			name:          "取得に失敗した場合はフィードの本文を使う",
+			config:        &entity.FullTextConfig{Enabled: testutil.BoolPtr(true)},
+			content:       "概要のみ",
+			extractor:     &extractorStub{err: errors.New("http error: 403")},
+			wantContent:   "概要のみ",
+			wantExtracted: true,
+		},
+		{
+			name:          "取得した本文の方が短い場合はフィードの本文を使う",
+			config:        &entity.FullTextConfig{Enabled: testutil.BoolPtr(true)},
+			content:       "フィードの概要",
+			extractor:     &extractorStub{text: "短い"},
+			wantContent:   "フィードの概要",
+			wantExtracted: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			articles := []entity.Article{
+				{Title: "記事", Link: "https://example.com/1", Content: tt.content, FeedURL: feedURL},
+			}
+			inner := &mockArticleSelector{
+				selectFunc: func(ctx context.Context, articles []entity.Article) (*entity.Article, error) {
+					return &articles[0], nil
+				},
+			}
+			selector := NewFullTextArticleSelector(inner, tt.extractor, tt.config, tt.feeds)
+
+			selected, err := selector.Select(context.Background(), articles)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantContent, selected.Content)
+			if tt.wantContent != tt.content {
+				// 置き換えた場合はフィードの本文を残す
+				assert.Equal(t, tt.content, selected.FeedContent)
+			} else {
+				assert.Empty(t, selected.FeedContent)
+			}
+			assert.Equal(t, tt.wantExtracted, len(tt.extractor.calls) > 0)
+			// 候補の記事自体は変更しない
+			assert.Equal(t, tt.content, articles[0].Content)
+		})
+	}
+}
+
+func TestFullTextArticleSelector_SelectError(t *testing.T) {
+	inner := &mockArticleSelector{
+		selectFunc: func(ctx context.Context, articles []entity.Article) (*entity.Article, error) {
+			return nil, errors.New("select failed")
+		},
+	}
+	extractor := &extractorStub{}
+	selector := NewFullTextArticleSelector(inner, extractor, &entity.FullTextConfig{Enabled: testutil.BoolPtr(true)}, nil)
+
+	_, err := selector.Select(context.Background(), []entity.Article{{Link: "https://example.com/1"}})
+	assert.EqualError(t, err, "select failed")
+	assert.Empty(t, extractor.calls)
+}
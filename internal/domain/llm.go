@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"context"
+)
+
+// LLMRequest はLLMに送信する生成リクエストを表す
+type LLMRequest struct {
+	SystemPrompt string // システムプロンプト（空の場合は送信しない）
+	Prompt       string // ユーザーのプロンプト
+}
+
+// JSONSchema は構造化出力で要求するJSONの形式を表す（プロバイダ間で共通に使える範囲のみ）
+type JSONSchema struct {
+	Type        string                 `json:"type"` // "object", "integer", "string" など
+	Description string                 `json:"description,omitempty"`
+	Properties  map[string]*JSONSchema `json:"properties,omitempty"`
+	Required    []string               `json:"required,omitempty"`
+	Minimum     *float64               `json:"minimum,omitempty"`
+	Maximum     *float64               `json:"maximum,omitempty"`
+}
+
+// LLMClient はLLMのプロバイダを抽象化したクライアントのインターフェース
+// 記事選択とコメント生成はこのインターフェースの上に実装し、プロバイダごとの違いはアダプタで吸収する
+type LLMClient interface {
+	// GenerateText は自由形式の文章を生成する
+	GenerateText(ctx context.Context, req LLMRequest) (string, error)
+	// GenerateJSON はschemaに従ったJSONを生成し、JSONオブジェクトの部分のみを返す
+	GenerateJSON(ctx context.Context, req LLMRequest, schema *JSONSchema) (string, error)
+}
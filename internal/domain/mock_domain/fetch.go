@@ -10,6 +10,7 @@
 package mock_domain
 
 import (
+	context "context"
 	reflect "reflect"
 
 	entity "github.com/canpok1/ai-feed/internal/domain/entity"
@@ -41,16 +42,16 @@ func (m *MockFetchClient) EXPECT() *MockFetchClientMockRecorder {
 }
 
 // Fetch mocks base method.
-func (m *MockFetchClient) Fetch(url string) ([]entity.Article, error) {
+func (m *MockFetchClient) Fetch(ctx context.Context, feed entity.Feed) ([]entity.Article, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Fetch", url)
+	ret := m.ctrl.Call(m, "Fetch", ctx, feed)
 	ret0, _ := ret[0].([]entity.Article)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Fetch indicates an expected call of Fetch.
-func (mr *MockFetchClientMockRecorder) Fetch(url any) *gomock.Call {
+func (mr *MockFetchClientMockRecorder) Fetch(ctx, feed any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fetch", reflect.TypeOf((*MockFetchClient)(nil).Fetch), url)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fetch", reflect.TypeOf((*MockFetchClient)(nil).Fetch), ctx, feed)
 }
@@ -1,8 +1,14 @@
 package mock_domain
 
+//go:generate mockgen -source=../canonical_url.go -destination=./canonical_url.go
 //go:generate mockgen -source=../comment.go -destination=./comment.go
 //go:generate mockgen -source=../config.go -destination=./config.go
+//go:generate mockgen -source=../feed_discovery.go -destination=./feed_discovery.go
+//go:generate mockgen -source=../feed_export.go -destination=./feed_export.go
+//go:generate mockgen -source=../feed_state.go -destination=./feed_state.go
 //go:generate mockgen -source=../fetch.go -destination=./fetch.go
+//go:generate mockgen -source=../full_text.go -destination=./full_text.go
+//go:generate mockgen -source=../llm.go -destination=./llm.go
 //go:generate mockgen -source=../message.go -destination=./message.go
 //go:generate mockgen -source=../recommend.go -destination=./recommend.go
 //go:generate mockgen -source=../profile.go -destination=./profile.go -package=mock_domain
@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"math/rand/v2"
+)
+
+// NewRandom はシードから乱数生成器を作成する
+// 同じシードからは同じ乱数列が得られるため、フィードや記事のランダムな選択を再現できる
+func NewRandom(seed uint64) *rand.Rand {
+	return rand.New(rand.NewPCG(seed, seed))
+}
+
+// NewRandomSeed は実行ごとに異なるシードを返す
+func NewRandomSeed() uint64 {
+	return rand.Uint64()
+}
+
+// randomIntN はrngから[0, n)の乱数を返す（rngがnilの場合はグローバルな乱数生成器を使う）
+func randomIntN(rng *rand.Rand, n int) int {
+	if rng == nil {
+		return rand.IntN(n)
+	}
+	return rng.IntN(n)
+}
+
+// randomFloat64 はrngから[0.0, 1.0)の乱数を返す（rngがnilの場合はグローバルな乱数生成器を使う）
+func randomFloat64(rng *rand.Rand) float64 {
+	if rng == nil {
+		return rand.Float64()
+	}
+	return rng.Float64()
+}
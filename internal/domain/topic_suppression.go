@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"hash/fnv"
+	"math/bits"
+	"time"
+
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+)
+
+const (
+	// fingerprintShingleSize は指紋の計算に使う文字n-gramの長さ
+	fingerprintShingleSize = 3
+	// fingerprintTitleWeight はタイトルの文字n-gramの重み（本文より話題をよく表すため重くする）
+	fingerprintTitleWeight = 3
+	// fingerprintMaxContentRunes は指紋の計算に使う本文の最大文字数
+	fingerprintMaxContentRunes = 2000
+)
+
+// ArticleFingerprint は記事のタイトルと本文からsimhashによる64bitの指紋を計算する
+// 話題が近い記事ほど指紋のビットの違いが少なくなる（特徴がない場合は0）
+// 推薦候補と推薦履歴で同じ本文を比べるように、記事ページから取得した本文ではなくフィードに記載されていた本文を使う
+func ArticleFingerprint(article entity.Article) uint64 {
+	feedContent := article.Content
+	if article.FeedContent != "" {
+		feedContent = article.FeedContent
+	}
+
+	var weights [64]int
+	addFingerprintFeatures(&weights, normalizedRunes(article.Title), fingerprintTitleWeight)
+	content := normalizedRunes(NormalizeContent(feedContent))
+	addFingerprintFeatures(&weights, content[:min(len(content), fingerprintMaxContentRunes)], 1)
+
+	var fingerprint uint64
+	for bit, weight := range weights {
+		if weight > 0 {
+			fingerprint |= 1 << bit
+		}
+	}
+	return fingerprint
+}
+
+// FingerprintSimilarity は2つの指紋の類似度（一致するビットの割合）を返す
+func FingerprintSimilarity(a, b uint64) float64 {
+	return 1 - float64(bits.OnesCount64(a^b))/64
+}
+
+// addFingerprintFeatures は文字n-gramのハッシュ値をsimhashの各ビットの重みに加える
+func addFingerprintFeatures(weights *[64]int, runes []rune, weight int) {
+	for i := 0; i+fingerprintShingleSize <= len(runes); i++ {
+		hash := fnv.New64a()
+		_, _ = hash.Write([]byte(string(runes[i : i+fingerprintShingleSize])))
+		sum := hash.Sum64()
+		for bit := range weights {
+			if sum&(1<<bit) != 0 {
+				weights[bit] += weight
+			} else {
+				weights[bit] -= weight
+			}
+		}
+	}
+}
+
+// SuppressedArticle は過去に推薦した記事と同じ話題とみなした記事を表す
+type SuppressedArticle struct {
+	Article    entity.Article
+	Similar    RecommendEntry // 最も似ている推薦済みの記事
+	Similarity float64
+}
+
+// TopicSuppressor は一定期間内に推薦した記事と同じ話題の記事を推薦候補から外す
+// URLが異なっても、タイトルと本文の指紋が近い記事は同じ話題とみなす
+type TopicSuppressor struct {
+	history    RecommendCache
+	similarity float64
+	window     time.Duration
+	action     string
+	now        func() time.Time
+}
+
+// NewTopicSuppressor はTopicSuppressorを作成する
+func NewTopicSuppressor(history RecommendCache, config *entity.TopicSuppressionConfig) *TopicSuppressor {
+	return &TopicSuppressor{
+		history:    history,
+		similarity: config.SimilarityOrDefault(),
+		window:     config.WindowOrDefault(),
+		action:     config.ActionOrDefault(),
+		now:        time.Now,
+	}
+}
+
+// Apply は推薦候補の記事と、同じ話題とみなした記事を返す
+// actionがdemoteの場合、同じ話題とみなした記事は外さずに推薦候補の末尾に元の順序で残す
+func (s *TopicSuppressor) Apply(articles []entity.Article) ([]entity.Article, []SuppressedArticle) {
+	var recent []RecommendEntry
+	for _, entry := range s.history.RecentEntries(s.now().Add(-s.window)) {
+		if entry.Fingerprint != 0 {
+			recent = append(recent, entry)
+		}
+	}
+	if len(recent) == 0 {
+		return articles, nil
+	}
+
+	var kept []entity.Article
+	var suppressed []SuppressedArticle
+	for _, article := range articles {
+		fingerprint := ArticleFingerprint(article)
+		if fingerprint == 0 {
+			kept = append(kept, article)
+			continue
+		}
+
+		var best SuppressedArticle
+		for _, entry := range recent {
+			if similarity := FingerprintSimilarity(fingerprint, entry.Fingerprint); similarity > best.Similarity {
+				best = SuppressedArticle{Article: article, Similar: entry, Similarity: similarity}
+			}
+		}
+		if best.Similarity >= s.similarity {
+			suppressed = append(suppressed, best)
+		} else {
+			kept = append(kept, article)
+		}
+	}
+
+	if s.action == entity.TopicSuppressionActionDemote {
+		for _, article := range suppressed {
+			kept = append(kept, article.Article)
+		}
+	}
+	return kept, suppressed
+}
@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArticleFingerprint(t *testing.T) {
+	original := entity.Article{
+		Title:   "Go 1.25 is released with a new garbage collector",
+		Content: "<p>The Go team announced Go 1.25 today. The release includes an experimental garbage collector and many performance improvements.</p>",
+	}
+	rewritten := entity.Article{
+		Title:   "Go 1.25 released with new garbage collector",
+		Content: "The Go team announced Go 1.25 today. This release includes an experimental garbage collector and various performance improvements.",
+	}
+	unrelated := entity.Article{
+		Title:   "Kubernetes 1.33 adds sidecar containers",
+		Content: "The Kubernetes project shipped a release focused on sidecar containers and scheduling.",
+	}
+
+	fingerprint := ArticleFingerprint(original)
+	assert.NotZero(t, fingerprint)
+	assert.Equal(t, fingerprint, ArticleFingerprint(original))
+	assert.Greater(t, FingerprintSimilarity(fingerprint, ArticleFingerprint(rewritten)),
+		FingerprintSimilarity(fingerprint, ArticleFingerprint(unrelated)))
+
+	// 記事ページから取得した本文で置き換えた記事も、フィードの本文で指紋を計算する
+	withFullText := original
+	withFullText.FeedContent = original.Content
+	withFullText.Content = strings.Repeat("The full article page talks about many other things in detail. ", 30)
+	assert.Equal(t, fingerprint, ArticleFingerprint(withFullText))
+	assert.Zero(t, ArticleFingerprint(entity.Article{}))
+}
+
+func TestFingerprintSimilarity(t *testing.T) {
+	assert.Equal(t, 1.0, FingerprintSimilarity(0xff, 0xff))
+	assert.Equal(t, 0.0, FingerprintSimilarity(0, ^uint64(0)))
+	assert.Equal(t, 1-2.0/64, FingerprintSimilarity(0b1010, 0b0110))
+}
+
+func TestTopicSuppressor_Apply(t *testing.T) {
+	now := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	posted := entity.Article{
+		Title:   "Go 1.25 is released with a new garbage collector",
+		Content: "The Go team announced Go 1.25 today with an experimental garbage collector.",
+	}
+	sameTopic := entity.Article{Link: "https://other.example.com/go125", Title: posted.Title, Content: posted.Content}
+	otherTopic := entity.Article{
+		Link:    "https://example.com/k8s",
+		Title:   "Kubernetes 1.33 adds sidecar containers",
+		Content: "The Kubernetes project shipped a release focused on sidecar containers.",
+	}
+
+	tests := []struct {
+		name           string
+		config         *entity.TopicSuppressionConfig
+		postedAt       time.Time
+		articles       []entity.Article
+		wantLinks      []string
+		wantSuppressed int
+	}{
+		{
+			name:           "期間内に推薦した記事と同じ話題の記事を外す",
+			postedAt:       now.Add(-24 * time.Hour),
+			articles:       []entity.Article{sameTopic, otherTopic},
+			wantLinks:      []string{otherTopic.Link},
+			wantSuppressed: 1,
+		},
+		{
+			name:      "期間外に推薦した記事は比較しない",
+			postedAt:  now.Add(-8 * 24 * time.Hour),
+			articles:  []entity.Article{sameTopic, otherTopic},
+			wantLinks: []string{sameTopic.Link, otherTopic.Link},
+		},
+		{
+			name:           "dropの場合はすべて外れることもある",
+			postedAt:       now.Add(-time.Hour),
+			articles:       []entity.Article{sameTopic},
+			wantLinks:      nil,
+			wantSuppressed: 1,
+		},
+		{
+			name:           "demoteの場合は他に候補がなければ残す",
+			config:         &entity.TopicSuppressionConfig{Action: entity.TopicSuppressionActionDemote},
+			postedAt:       now.Add(-time.Hour),
+			articles:       []entity.Article{sameTopic},
+			wantLinks:      []string{sameTopic.Link},
+			wantSuppressed: 1,
+		},
+		{
+			name:           "demoteの場合は他の候補の後ろに残す",
+			config:         &entity.TopicSuppressionConfig{Action: entity.TopicSuppressionActionDemote},
+			postedAt:       now.Add(-time.Hour),
+			articles:       []entity.Article{sameTopic, otherTopic},
+			wantLinks:      []string{otherTopic.Link, sameTopic.Link},
+			wantSuppressed: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			history := &historyStub{entries: []RecommendEntry{
+				{URL: "https://example.com/go125", Title: posted.Title, PostedAt: tt.postedAt, Fingerprint: ArticleFingerprint(posted)},
+			}}
+			suppressor := NewTopicSuppressor(history, tt.config)
+			suppressor.now = func() time.Time { return now }
+
+			kept, suppressed := suppressor.Apply(tt.articles)
+
+			var links []string
+			for _, article := range kept {
+				links = append(links, article.Link)
+			}
+			assert.Equal(t, tt.wantLinks, links)
+			require.Len(t, suppressed, tt.wantSuppressed)
+			for _, article := range suppressed {
+				assert.Equal(t, "https://example.com/go125", article.Similar.URL)
+				assert.GreaterOrEqual(t, article.Similarity, entity.DefaultTopicSimilarity)
+			}
+		})
+	}
+}
@@ -28,6 +28,20 @@ type ConfigSummary struct {
 	GeminiConfigured bool
 	// GeminiModel は設定されているGeminiモデル
 	GeminiModel string
+	// OpenAIConfigured はOpenAI互換APIの設定状態
+	OpenAIConfigured bool
+	// OpenAIModel は設定されているOpenAI互換APIのモデル
+	OpenAIModel string
+	// OpenAIBaseURL は設定されているOpenAI互換APIのベースURL
+	OpenAIBaseURL string
+	// OllamaConfigured はOllamaの設定状態
+	OllamaConfigured bool
+	// OllamaModel は設定されているOllamaのモデル
+	OllamaModel string
+	// OllamaBaseURL は設定されているOllamaのベースURL
+	OllamaBaseURL string
+	// AIChain は設定されているAIの呼び出し順
+	AIChain []string
 	// SystemPromptConfigured はシステムプロンプトの設定状態
 	SystemPromptConfigured bool
 	// CommentPromptConfigured はコメントプロンプトの設定状態
@@ -0,0 +1,139 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/canpok1/ai-feed/internal/domain"
+)
+
+// CachedArticleExtractor wraps an ArticleExtractor and keeps extracted article text on disk.
+// Each article is stored as a text file named after the SHA-256 hash of its URL.
+type CachedArticleExtractor struct {
+	extractor domain.ArticleExtractor
+	dir       string
+	ttl       time.Duration
+	now       func() time.Time
+}
+
+// NewCachedArticleExtractor creates a new CachedArticleExtractor instance.
+// Cached text older than ttl is fetched again (a zero ttl keeps it forever).
+func NewCachedArticleExtractor(extractor domain.ArticleExtractor, dir string, ttl time.Duration) *CachedArticleExtractor {
+	return &CachedArticleExtractor{
+		extractor: extractor,
+		dir:       dir,
+		ttl:       ttl,
+		now:       time.Now,
+	}
+}
+
+// Initialize removes cached article files that have expired.
+// Expired files are ignored by Extract anyway, so this only keeps the directory from growing.
+func (c *CachedArticleExtractor) Initialize() error {
+	if c.ttl <= 0 {
+		return nil
+	}
+
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read article cache directory: %w", err)
+	}
+
+	removedCount := 0
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".txt") {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil || c.now().Sub(info.ModTime()) <= c.ttl {
+			continue
+		}
+		path := filepath.Join(c.dir, entry.Name())
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			slog.Warn("Failed to remove expired article cache", "file_path", path, "error", err)
+			continue
+		}
+		removedCount++
+	}
+
+	if removedCount > 0 {
+		slog.Debug("Cleaned up expired article cache", "removed_count", removedCount, "dir", c.dir)
+	}
+	return nil
+}
+
+// Extract returns the cached text for the URL, or extracts and caches it
+func (c *CachedArticleExtractor) Extract(ctx context.Context, articleURL string) (string, error) {
+	path := c.filePath(articleURL)
+	if text, ok := c.load(path); ok {
+		slog.Debug("Using cached article text", "url", articleURL, "file_path", path)
+		return text, nil
+	}
+
+	text, err := c.extractor.Extract(ctx, articleURL)
+	if err != nil {
+		return "", err
+	}
+
+	// Failing to cache only costs another download next time, so don't fail the extraction
+	if err := c.save(path, text); err != nil {
+		slog.Warn("Failed to cache article text", "url", articleURL, "file_path", path, "error", err)
+	}
+	return text, nil
+}
+
+// filePath returns the cache file path for the URL
+func (c *CachedArticleExtractor) filePath(articleURL string) string {
+	sum := sha256.Sum256([]byte(articleURL))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+".txt")
+}
+
+// load reads the cached text if it exists and has not expired
+func (c *CachedArticleExtractor) load(path string) (string, bool) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", false
+	}
+	if c.ttl > 0 && c.now().Sub(info.ModTime()) > c.ttl {
+		return "", false
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", false
+	}
+	return string(data), true
+}
+
+// save writes the text to the cache file
+func (c *CachedArticleExtractor) save(path, text string) error {
+	if err := os.MkdirAll(c.dir, 0755); err != nil {
+		return domain.ErrCacheDirectoryCreate
+	}
+
+	// Write to a temporary file and rename for atomic replace
+	tempPath := path + ".tmp"
+	if err := os.WriteFile(tempPath, []byte(text), 0644); err != nil {
+		if os.IsPermission(err) {
+			return domain.ErrCachePermission
+		}
+		return err
+	}
+	if err := os.Rename(tempPath, path); err != nil {
+		os.Remove(tempPath)
+		return err
+	}
+	return nil
+}
+
+// Verify that CachedArticleExtractor implements ArticleExtractor interface
+var _ domain.ArticleExtractor = (*CachedArticleExtractor)(nil)
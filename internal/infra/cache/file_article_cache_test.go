@@ -0,0 +1,99 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/canpok1/ai-feed/internal/domain/mock_domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCachedArticleExtractor_Extract(t *testing.T) {
+	const articleURL = "https://example.com/article"
+
+	t.Run("取得した本文をキャッシュし、2回目はキャッシュから返す", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		inner := mock_domain.NewMockArticleExtractor(ctrl)
+		inner.EXPECT().Extract(gomock.Any(), articleURL).Return("full text", nil).Times(1)
+
+		dir := filepath.Join(t.TempDir(), "articles")
+		extractor := NewCachedArticleExtractor(inner, dir, time.Hour)
+
+		for range 2 {
+			text, err := extractor.Extract(context.Background(), articleURL)
+			require.NoError(t, err)
+			assert.Equal(t, "full text", text)
+		}
+
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		assert.Len(t, entries, 1)
+	})
+
+	t.Run("期限切れのキャッシュは再取得する", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		inner := mock_domain.NewMockArticleExtractor(ctrl)
+		gomock.InOrder(
+			inner.EXPECT().Extract(gomock.Any(), articleURL).Return("old text", nil),
+			inner.EXPECT().Extract(gomock.Any(), articleURL).Return("new text", nil),
+		)
+
+		extractor := NewCachedArticleExtractor(inner, t.TempDir(), time.Hour)
+		_, err := extractor.Extract(context.Background(), articleURL)
+		require.NoError(t, err)
+
+		extractor.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+		text, err := extractor.Extract(context.Background(), articleURL)
+		require.NoError(t, err)
+		assert.Equal(t, "new text", text)
+	})
+
+	t.Run("取得に失敗した場合はキャッシュしない", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		inner := mock_domain.NewMockArticleExtractor(ctrl)
+		inner.EXPECT().Extract(gomock.Any(), articleURL).Return("", errors.New("http error: 404"))
+
+		dir := t.TempDir()
+		extractor := NewCachedArticleExtractor(inner, dir, time.Hour)
+		_, err := extractor.Extract(context.Background(), articleURL)
+		assert.Error(t, err)
+
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+}
+
+func TestCachedArticleExtractor_Initialize(t *testing.T) {
+	t.Run("保持期間を過ぎた本文のファイルを削除する", func(t *testing.T) {
+		dir := t.TempDir()
+		extractor := NewCachedArticleExtractor(nil, dir, 24*time.Hour)
+		expired := extractor.filePath("https://example.com/old")
+		fresh := extractor.filePath("https://example.com/new")
+		other := filepath.Join(dir, "memo.md")
+		for _, path := range []string{expired, fresh, other} {
+			require.NoError(t, os.WriteFile(path, []byte("text"), 0644))
+		}
+		old := time.Now().Add(-48 * time.Hour)
+		require.NoError(t, os.Chtimes(expired, old, old))
+		require.NoError(t, os.Chtimes(other, old, old))
+
+		require.NoError(t, extractor.Initialize())
+
+		_, err := os.Stat(expired)
+		assert.True(t, os.IsNotExist(err), "期限切れのファイルは削除される")
+		assert.FileExists(t, fresh)
+		assert.FileExists(t, other, "キャッシュ以外のファイルは削除しない")
+	})
+
+	t.Run("ディレクトリがない場合は何もしない", func(t *testing.T) {
+		extractor := NewCachedArticleExtractor(nil, filepath.Join(t.TempDir(), "articles"), time.Hour)
+		assert.NoError(t, extractor.Initialize())
+	})
+}
@@ -14,6 +14,11 @@ import (
 	"github.com/canpok1/ai-feed/internal/domain/entity"
 )
 
+// keyNormalizer computes the keys used to detect duplicate URLs.
+// Article links are normalized with the profile settings before they reach the cache,
+// so only the default tracking parameters are stripped here.
+var keyNormalizer = domain.NewURLNormalizer(nil)
+
 // FileRecommendCache implements RecommendCache interface using JSON Lines file format
 type FileRecommendCache struct {
 	filePath string
@@ -73,9 +78,9 @@ func (c *FileRecommendCache) IsCached(url string) bool {
 	return c.urlSet[normalizedURL]
 }
 
-// AddEntry adds a new entry to the cache with the given URL and title
-func (c *FileRecommendCache) AddEntry(url, title string) error {
-	normalizedURL := c.normalizeURL(url)
+// AddEntry adds the given article to the cache
+func (c *FileRecommendCache) AddEntry(article entity.Article) error {
+	normalizedURL := c.normalizeURL(article.Link)
 
 	// Check if already exists
 	if c.urlSet[normalizedURL] {
@@ -85,14 +90,24 @@ func (c *FileRecommendCache) AddEntry(url, title string) error {
 
 	// Create new entry
 	entry := domain.RecommendEntry{
-		URL:      normalizedURL,
-		Title:    title,
-		PostedAt: time.Now(),
+		URL:            article.Link,
+		OriginalURL:    article.OriginalLink,
+		Title:          article.Title,
+		PostedAt:       time.Now(),
+		FeedURL:        article.FeedURL,
+		FeedTitle:      article.FeedTitle,
+		GUID:           article.GUID,
+		Author:         article.Author,
+		Categories:     article.Categories,
+		ImageURL:       article.ImageURL,
+		Published:      article.Published,
+		SourceFeedURLs: article.SourceFeedURLs,
+		Fingerprint:    domain.ArticleFingerprint(article),
 	}
 
 	// Add to in-memory structures
 	c.entries = append(c.entries, entry)
-	c.urlSet[normalizedURL] = true
+	c.addURLKeys(entry)
 
 	// Cleanup if necessary (FIFO when max_entries exceeded)
 	c.cleanupByMaxEntries()
@@ -102,10 +117,39 @@ func (c *FileRecommendCache) AddEntry(url, title string) error {
 		return fmt.Errorf("failed to save cache: %w", err)
 	}
 
-	slog.Debug("Added entry to cache", "url", normalizedURL, "title", title)
+	slog.Debug("Added entry to cache", "url", normalizedURL, "title", article.Title, "feed_url", article.FeedURL)
 	return nil
 }
 
+// LastRecommendedAt returns when an article from the given feed URL was last recommended
+func (c *FileRecommendCache) LastRecommendedAt(feedURL string) (time.Time, bool) {
+	normalizedFeedURL := c.normalizeURL(feedURL)
+
+	var lastPostedAt time.Time
+	found := false
+	for _, entry := range c.entries {
+		if entry.FeedURL == "" || c.normalizeURL(entry.FeedURL) != normalizedFeedURL {
+			continue
+		}
+		if !found || entry.PostedAt.After(lastPostedAt) {
+			lastPostedAt = entry.PostedAt
+			found = true
+		}
+	}
+	return lastPostedAt, found
+}
+
+// RecentEntries returns the entries posted at or after the given time
+func (c *FileRecommendCache) RecentEntries(since time.Time) []domain.RecommendEntry {
+	var recent []domain.RecommendEntry
+	for _, entry := range c.entries {
+		if !entry.PostedAt.Before(since) {
+			recent = append(recent, entry)
+		}
+	}
+	return recent
+}
+
 // Close closes the cache, releases locks and performs cleanup
 func (c *FileRecommendCache) Close() error {
 	if c.lockFile != nil {
@@ -163,8 +207,7 @@ func (c *FileRecommendCache) loadFromFile() error {
 		}
 
 		validEntries = append(validEntries, entry)
-		normalizedURL := c.normalizeURL(entry.URL)
-		c.urlSet[normalizedURL] = true
+		c.addURLKeys(entry)
 	}
 
 	if err := scanner.Err(); err != nil {
@@ -231,38 +274,21 @@ func (c *FileRecommendCache) saveToFile() error {
 
 // acquireLock acquires the cache file lock
 func (c *FileRecommendCache) acquireLock() error {
-	// Ensure lock file directory exists
-	lockDir := filepath.Dir(c.lockPath)
-	if _, err := os.Stat(lockDir); os.IsNotExist(err) {
-		slog.Debug("Creating lock file directory", "dir", lockDir)
-		if err := os.MkdirAll(lockDir, 0755); err != nil {
-			return fmt.Errorf("failed to create lock file directory: %w", err)
-		}
-	}
-
-	lockFile, err := os.OpenFile(c.lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+	lockFile, err := acquireLockFile(c.lockPath)
 	if err != nil {
-		if os.IsExist(err) {
-			return fmt.Errorf("%w: lock file exists at %s. If no other ai-feed process is running, manually delete the lock file", domain.ErrCacheLocked, c.lockPath)
-		}
-		return fmt.Errorf("failed to create lock file: %w", err)
+		return err
 	}
-
 	c.lockFile = lockFile
-	slog.Debug("Acquired cache lock", "lock_path", c.lockPath)
 	return nil
 }
 
 // releaseLock releases the cache file lock
 func (c *FileRecommendCache) releaseLock() error {
 	if c.lockFile != nil {
-		_ = c.lockFile.Close()
-		if err := os.Remove(c.lockPath); err != nil && !os.IsNotExist(err) {
-			slog.Warn("Failed to remove lock file", "lock_path", c.lockPath, "error", err.Error())
+		if err := releaseLockFile(c.lockFile, c.lockPath); err != nil {
 			return err
 		}
 		c.lockFile = nil
-		slog.Debug("Released cache lock", "lock_path", c.lockPath)
 	}
 	return nil
 }
@@ -282,8 +308,7 @@ func (c *FileRecommendCache) cleanup() {
 			validEntries = append(validEntries, entry)
 		} else {
 			// Remove expired entry from URL set
-			normalizedURL := c.normalizeURL(entry.URL)
-			delete(c.urlSet, normalizedURL)
+			c.removeURLKeys(entry)
 			removedCount++
 		}
 	}
@@ -306,15 +331,30 @@ func (c *FileRecommendCache) cleanupByMaxEntries() {
 	// Remove oldest entries (FIFO) and their corresponding URLs from urlSet
 	removedEntries := c.entries[:excessCount]
 	for _, entry := range removedEntries {
-		normalizedURL := c.normalizeURL(entry.URL)
-		delete(c.urlSet, normalizedURL)
+		c.removeURLKeys(entry)
 	}
 	c.entries = c.entries[excessCount:]
 
 	slog.Debug("Cleaned up excess cache entries", "removed_count", len(removedEntries), "remaining_count", len(c.entries))
 }
 
-// normalizeURL normalizes URL by removing trailing slash
+// addURLKeys registers the canonical URL and the original feed URL of the entry as posted
+func (c *FileRecommendCache) addURLKeys(entry domain.RecommendEntry) {
+	c.urlSet[c.normalizeURL(entry.URL)] = true
+	if entry.OriginalURL != "" {
+		c.urlSet[c.normalizeURL(entry.OriginalURL)] = true
+	}
+}
+
+// removeURLKeys removes the URLs registered by addURLKeys
+func (c *FileRecommendCache) removeURLKeys(entry domain.RecommendEntry) {
+	delete(c.urlSet, c.normalizeURL(entry.URL))
+	if entry.OriginalURL != "" {
+		delete(c.urlSet, c.normalizeURL(entry.OriginalURL))
+	}
+}
+
+// normalizeURL normalizes URL so that tracking parameters, http/https, www. and trailing slashes are ignored
 func (c *FileRecommendCache) normalizeURL(url string) string {
-	return strings.TrimSuffix(url, "/")
+	return keyNormalizer.Key(url)
 }
@@ -128,14 +128,14 @@ func TestFileRecommendCache_IsCached(t *testing.T) {
 	})
 
 	t.Run("キャッシュされたURL", func(t *testing.T) {
-		cache.AddEntry("https://example.com/cached", "Test Article")
+		cache.AddEntry(entity.Article{Link: "https://example.com/cached", Title: "Test Article"})
 		if !cache.IsCached("https://example.com/cached") {
 			t.Error("URL should be cached")
 		}
 	})
 
 	t.Run("URL正規化", func(t *testing.T) {
-		cache.AddEntry("https://example.com/test/", "Test Article")
+		cache.AddEntry(entity.Article{Link: "https://example.com/test/", Title: "Test Article"})
 		if !cache.IsCached("https://example.com/test") {
 			t.Error("URL normalization should work (trailing slash)")
 		}
@@ -156,7 +156,7 @@ func TestFileRecommendCache_AddEntry(t *testing.T) {
 	defer cache.Close()
 
 	t.Run("新しいエントリの追加", func(t *testing.T) {
-		err := cache.AddEntry("https://example.com/new", "New Article")
+		err := cache.AddEntry(entity.Article{Link: "https://example.com/new", Title: "New Article"})
 		if err != nil {
 			t.Fatalf("AddEntry failed: %v", err)
 		}
@@ -169,9 +169,69 @@ func TestFileRecommendCache_AddEntry(t *testing.T) {
 		}
 	})
 
+	t.Run("記事のメタデータを履歴に保存する", func(t *testing.T) {
+		published := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		err := cache.AddEntry(entity.Article{
+			Link:       "https://example.com/meta",
+			Title:      "Meta Article",
+			Published:  &published,
+			Author:     "Alice",
+			GUID:       "urn:example:meta",
+			ImageURL:   "https://example.com/meta.png",
+			Categories: []string{"Go"},
+			FeedURL:    "https://example.com/feed.xml",
+			FeedTitle:  "Example Blog",
+		})
+		if err != nil {
+			t.Fatalf("AddEntry failed: %v", err)
+		}
+
+		entry := cache.entries[len(cache.entries)-1]
+		if entry.Author != "Alice" || entry.GUID != "urn:example:meta" || entry.ImageURL != "https://example.com/meta.png" {
+			t.Errorf("Unexpected entry metadata: %+v", entry)
+		}
+		if len(entry.Categories) != 1 || entry.Categories[0] != "Go" {
+			t.Errorf("Expected categories [Go], got %v", entry.Categories)
+		}
+		if entry.FeedTitle != "Example Blog" {
+			t.Errorf("Expected feed title Example Blog, got %s", entry.FeedTitle)
+		}
+		if entry.Published == nil || !entry.Published.Equal(published) {
+			t.Errorf("Expected published %v, got %v", published, entry.Published)
+		}
+		if entry.Fingerprint == 0 {
+			t.Error("Expected fingerprint to be recorded")
+		}
+	})
+
+	t.Run("正規化前のURLも投稿済みとして扱う", func(t *testing.T) {
+		err := cache.AddEntry(entity.Article{
+			Link:         "https://blog.example.com/posts/1",
+			OriginalLink: "https://feedproxy.example.com/~r/blog/1",
+			Title:        "Redirected Article",
+		})
+		if err != nil {
+			t.Fatalf("AddEntry failed: %v", err)
+		}
+
+		entry := cache.entries[len(cache.entries)-1]
+		if entry.URL != "https://blog.example.com/posts/1" || entry.OriginalURL != "https://feedproxy.example.com/~r/blog/1" {
+			t.Errorf("Unexpected entry URLs: %+v", entry)
+		}
+		for _, url := range []string{
+			"https://blog.example.com/posts/1",
+			"http://www.blog.example.com/posts/1/?utm_source=rss",
+			"https://feedproxy.example.com/~r/blog/1",
+		} {
+			if !cache.IsCached(url) {
+				t.Errorf("Expected %s to be cached", url)
+			}
+		}
+	})
+
 	t.Run("重複エントリの追加", func(t *testing.T) {
 		initialCount := len(cache.entries)
-		err := cache.AddEntry("https://example.com/new", "Same Article")
+		err := cache.AddEntry(entity.Article{Link: "https://example.com/new", Title: "Same Article"})
 		if err != nil {
 			t.Fatalf("AddEntry failed: %v", err)
 		}
@@ -182,6 +242,104 @@ func TestFileRecommendCache_AddEntry(t *testing.T) {
 	})
 }
 
+func TestFileRecommendCache_LastRecommendedAt(t *testing.T) {
+	tmpDir := t.TempDir()
+	config := &entity.CacheConfig{
+		Enabled:       testutil.BoolPtr(true),
+		FilePath:      filepath.Join(tmpDir, "cache.jsonl"),
+		MaxEntries:    100,
+		RetentionDays: 7,
+	}
+
+	// 既存の履歴ファイルを作成（フィードURLを持たない旧形式のエントリを含む）
+	now := time.Now()
+	entries := []domain.RecommendEntry{
+		{URL: "https://example.com/a1", Title: "A1", PostedAt: now.Add(-48 * time.Hour), FeedURL: "https://example.com/feed-a"},
+		{URL: "https://example.com/a2", Title: "A2", PostedAt: now.Add(-24 * time.Hour), FeedURL: "https://example.com/feed-a/"},
+		{URL: "https://example.com/b1", Title: "B1", PostedAt: now.Add(-72 * time.Hour), FeedURL: "https://example.com/feed-b"},
+		{URL: "https://example.com/old", Title: "Old", PostedAt: now.Add(-1 * time.Hour)},
+	}
+	var lines []string
+	for _, entry := range entries {
+		data, _ := json.Marshal(entry)
+		lines = append(lines, string(data))
+	}
+	if err := os.WriteFile(config.FilePath, []byte(strings.Join(lines, "\n")+"\n"), 0644); err != nil {
+		t.Fatalf("Failed to write cache file: %v", err)
+	}
+
+	cache := NewFileRecommendCache(config)
+	if err := cache.Initialize(); err != nil {
+		t.Fatalf("Initialize failed: %v", err)
+	}
+	defer cache.Close()
+
+	t.Run("フィードごとの最新の推薦日時を返す", func(t *testing.T) {
+		got, ok := cache.LastRecommendedAt("https://example.com/feed-a")
+		if !ok {
+			t.Fatal("feed-a should be found")
+		}
+		if !got.Equal(entries[1].PostedAt) {
+			t.Errorf("Expected %v, got %v", entries[1].PostedAt, got)
+		}
+	})
+
+	t.Run("推薦履歴のないフィード", func(t *testing.T) {
+		if _, ok := cache.LastRecommendedAt("https://example.com/feed-c"); ok {
+			t.Error("feed-c should not be found")
+		}
+	})
+
+	t.Run("追加したエントリのフィードURLが記録される", func(t *testing.T) {
+		err := cache.AddEntry(entity.Article{Link: "https://example.com/c1", Title: "C1", FeedURL: "https://example.com/feed-c"})
+		if err != nil {
+			t.Fatalf("AddEntry failed: %v", err)
+		}
+		if _, ok := cache.LastRecommendedAt("https://example.com/feed-c"); !ok {
+			t.Error("feed-c should be found after AddEntry")
+		}
+	})
+}
+
+func TestFileRecommendCache_RecentEntries(t *testing.T) {
+	now := time.Now()
+	cache := NewFileRecommendCache(&entity.CacheConfig{FilePath: filepath.Join(t.TempDir(), "cache.jsonl")})
+	cache.entries = []domain.RecommendEntry{
+		{URL: "https://example.com/old", PostedAt: now.Add(-10 * 24 * time.Hour)},
+		{URL: "https://example.com/new", PostedAt: now.Add(-time.Hour), Fingerprint: 42},
+	}
+
+	recent := cache.RecentEntries(now.Add(-7 * 24 * time.Hour))
+
+	if len(recent) != 1 || recent[0].URL != "https://example.com/new" {
+		t.Fatalf("Expected only the new entry, got %+v", recent)
+	}
+	if recent[0].Fingerprint != 42 {
+		t.Errorf("Expected fingerprint 42, got %d", recent[0].Fingerprint)
+	}
+}
+
+func TestFileRecommendCache_AddEntry_FingerprintUsesFeedContent(t *testing.T) {
+	cache := NewFileRecommendCache(&entity.CacheConfig{FilePath: filepath.Join(t.TempDir(), "cache.jsonl")})
+	candidate := entity.Article{
+		Title:   "Go 1.25 is released",
+		Link:    "https://example.com/go125",
+		Content: "<p>The Go team announced Go 1.25 today.</p>",
+	}
+	// 記事ページから取得した本文で置き換えた後の記事を推薦履歴に追加する
+	recommended := candidate
+	recommended.FeedContent = candidate.Content
+	recommended.Content = "The full article page with a long body about many other topics."
+
+	if err := cache.AddEntry(recommended); err != nil {
+		t.Fatalf("AddEntry failed: %v", err)
+	}
+
+	if got, want := cache.entries[0].Fingerprint, domain.ArticleFingerprint(candidate); got != want {
+		t.Errorf("Expected the fingerprint of the feed content %d, got %d", want, got)
+	}
+}
+
 func TestFileRecommendCache_Close(t *testing.T) {
 	tmpDir := t.TempDir()
 	config := &entity.CacheConfig{
@@ -415,6 +573,8 @@ func TestFileRecommendCache_normalizeURL(t *testing.T) {
 		{"https://example.com", "https://example.com"},
 		{"https://example.com/path/", "https://example.com/path"},
 		{"https://example.com/path", "https://example.com/path"},
+		{"http://www.example.com/path?utm_source=rss#top", "https://example.com/path"},
+		{"https://example.com/path?b=2&a=1", "https://example.com/path?a=1&b=2"},
 	}
 
 	for _, tc := range testCases {
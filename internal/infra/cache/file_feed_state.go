@@ -0,0 +1,176 @@
+package cache
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/canpok1/ai-feed/internal/domain"
+)
+
+// FileFeedStateStore implements FeedStateStore interface using a JSON file.
+// States are kept in memory and written to the file on Close.
+// Like FileRecommendCache, the file is locked from Initialize until Close
+// so that concurrent runs do not overwrite each other's states.
+type FileFeedStateStore struct {
+	filePath string
+	lockPath string
+	mu       sync.Mutex
+	states   map[string]domain.FeedState
+	dirty    bool
+	lockFile *os.File
+}
+
+// NewFileFeedStateStore creates a new FileFeedStateStore instance
+func NewFileFeedStateStore(filePath string) *FileFeedStateStore {
+	return &FileFeedStateStore{
+		filePath: filePath,
+		lockPath: filePath + ".lock",
+		states:   make(map[string]domain.FeedState),
+	}
+}
+
+// Initialize acquires the lock and loads existing feed states from the file
+func (s *FileFeedStateStore) Initialize() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	slog.Debug("Initializing file feed state store", "file_path", s.filePath)
+
+	lockFile, err := acquireLockFile(s.lockPath)
+	if err != nil {
+		return fmt.Errorf("failed to acquire lock: %w", err)
+	}
+	s.lockFile = lockFile
+
+	if err := s.loadFromFile(); err != nil {
+		// Release lock on error (ignore release error as load already failed)
+		_ = s.releaseLock()
+		return err
+	}
+	return nil
+}
+
+// loadFromFile loads feed states from the JSON file
+func (s *FileFeedStateStore) loadFromFile() error {
+	data, err := os.ReadFile(s.filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			slog.Debug("Feed state file does not exist, starting with empty state", "file_path", s.filePath)
+			return nil
+		}
+		if os.IsPermission(err) {
+			return domain.ErrCachePermission
+		}
+		return fmt.Errorf("failed to read feed state file: %w", err)
+	}
+
+	var states []domain.FeedState
+	if err := json.Unmarshal(data, &states); err != nil {
+		// A broken state file only disables conditional requests, so start over instead of failing
+		slog.Warn("Ignoring corrupted feed state file", "file_path", s.filePath, "error", err.Error())
+		return nil
+	}
+
+	for _, state := range states {
+		s.states[state.URL] = state
+	}
+
+	slog.Debug("Loaded feed states from file", "count", len(s.states))
+	return nil
+}
+
+// Get returns the state of the given feed URL
+func (s *FileFeedStateStore) Get(url string) (domain.FeedState, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, ok := s.states[url]
+	return state, ok
+}
+
+// Update updates the state of the feed in memory
+func (s *FileFeedStateStore) Update(state domain.FeedState) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.states[state.URL] = state
+	s.dirty = true
+	return nil
+}
+
+// Close writes updated feed states to the file and releases the lock
+func (s *FileFeedStateStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var saveErr error
+	if s.dirty {
+		if err := s.saveToFile(); err != nil {
+			saveErr = fmt.Errorf("failed to save feed state: %w", err)
+		} else {
+			s.dirty = false
+		}
+	}
+	if err := s.releaseLock(); err != nil {
+		return errors.Join(saveErr, fmt.Errorf("failed to release lock: %w", err))
+	}
+	return saveErr
+}
+
+// releaseLock releases the feed state file lock
+func (s *FileFeedStateStore) releaseLock() error {
+	if s.lockFile != nil {
+		if err := releaseLockFile(s.lockFile, s.lockPath); err != nil {
+			return err
+		}
+		s.lockFile = nil
+	}
+	return nil
+}
+
+// saveToFile saves all feed states to the JSON file
+func (s *FileFeedStateStore) saveToFile() error {
+	dir := filepath.Dir(s.filePath)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return domain.ErrCacheDirectoryCreate
+	}
+
+	states := make([]domain.FeedState, 0, len(s.states))
+	for _, state := range s.states {
+		states = append(states, state)
+	}
+	// Keep the file stable across runs for easier diffing
+	sort.Slice(states, func(i, j int) bool {
+		return states[i].URL < states[j].URL
+	})
+
+	data, err := json.MarshalIndent(states, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal feed states: %w", err)
+	}
+
+	// Write to a temporary file and rename for atomic replace
+	tempPath := s.filePath + ".tmp"
+	if err := os.WriteFile(tempPath, data, 0644); err != nil {
+		if os.IsPermission(err) {
+			return domain.ErrCachePermission
+		}
+		return fmt.Errorf("failed to write temporary feed state file: %w", err)
+	}
+	if err := os.Rename(tempPath, s.filePath); err != nil {
+		os.Remove(tempPath)
+		return fmt.Errorf("failed to replace feed state file: %w", err)
+	}
+
+	slog.Debug("Saved feed states to file", "count", len(states))
+	return nil
+}
+
+// Verify that FileFeedStateStore implements FeedStateStore interface
+var _ domain.FeedStateStore = (*FileFeedStateStore)(nil)
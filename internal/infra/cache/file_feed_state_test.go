@@ -0,0 +1,111 @@
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/canpok1/ai-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileFeedStateStore_RoundTrip(t *testing.T) {
+	tmpDir := t.TempDir()
+	filePath := filepath.Join(tmpDir, "nested", "feed_state.json")
+	fetchedAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	store := NewFileFeedStateStore(filePath)
+	require.NoError(t, store.Initialize())
+
+	_, ok := store.Get("https://example.com/feed.xml")
+	assert.False(t, ok)
+
+	state := domain.FeedState{
+		URL:           "https://example.com/feed.xml",
+		ETag:          `"abc"`,
+		LastModified:  "Mon, 01 Jan 2024 00:00:00 GMT",
+		LastFetchedAt: fetchedAt,
+	}
+	require.NoError(t, store.Update(state))
+	require.NoError(t, store.Close())
+
+	reloaded := NewFileFeedStateStore(filePath)
+	require.NoError(t, reloaded.Initialize())
+	got, ok := reloaded.Get("https://example.com/feed.xml")
+	require.True(t, ok)
+	assert.Equal(t, state.ETag, got.ETag)
+	assert.Equal(t, state.LastModified, got.LastModified)
+	assert.True(t, fetchedAt.Equal(got.LastFetchedAt))
+}
+
+func TestFileFeedStateStore_Initialize(t *testing.T) {
+	tests := []struct {
+		name    string
+		content *string
+	}{
+		{name: "ファイルが存在しない場合は空の状態で開始する", content: nil},
+		{name: "壊れたファイルは無視して空の状態で開始する", content: func() *string { s := "{invalid"; return &s }()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filePath := filepath.Join(t.TempDir(), "feed_state.json")
+			if tt.content != nil {
+				require.NoError(t, os.WriteFile(filePath, []byte(*tt.content), 0644))
+			}
+
+			store := NewFileFeedStateStore(filePath)
+			require.NoError(t, store.Initialize())
+			assert.Empty(t, store.states)
+		})
+	}
+}
+
+func TestFileFeedStateStore_Close(t *testing.T) {
+	t.Run("更新がない場合はファイルを作成しない", func(t *testing.T) {
+		filePath := filepath.Join(t.TempDir(), "feed_state.json")
+
+		store := NewFileFeedStateStore(filePath)
+		require.NoError(t, store.Initialize())
+		require.NoError(t, store.Close())
+
+		_, err := os.Stat(filePath)
+		assert.True(t, os.IsNotExist(err))
+	})
+}
+
+func TestFileFeedStateStore_Lock(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "feed_state.json")
+
+	first := NewFileFeedStateStore(filePath)
+	require.NoError(t, first.Initialize())
+	_, err := os.Stat(filePath + ".lock")
+	require.NoError(t, err, "Initialize後はロックファイルが存在する")
+
+	second := NewFileFeedStateStore(filePath)
+	err = second.Initialize()
+	assert.ErrorIs(t, err, domain.ErrCacheLocked)
+
+	require.NoError(t, first.Update(domain.FeedState{URL: "https://example.com/feed.xml", ETag: `"abc"`}))
+	require.NoError(t, first.Close())
+	_, err = os.Stat(filePath + ".lock")
+	assert.True(t, os.IsNotExist(err), "Close後はロックファイルが削除される")
+
+	require.NoError(t, second.Initialize())
+	defer func() { require.NoError(t, second.Close()) }()
+	state, ok := second.Get("https://example.com/feed.xml")
+	require.True(t, ok)
+	assert.Equal(t, `"abc"`, state.ETag)
+}
+
+func TestNopFeedStateStore(t *testing.T) {
+	store := NewNopFeedStateStore()
+
+	assert.NoError(t, store.Initialize())
+	assert.NoError(t, store.Update(domain.FeedState{URL: "https://example.com/feed.xml", ETag: "x"}))
+	_, ok := store.Get("https://example.com/feed.xml")
+	assert.False(t, ok)
+	assert.NoError(t, store.Close())
+}
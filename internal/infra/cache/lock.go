@@ -0,0 +1,45 @@
+package cache
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	"github.com/canpok1/ai-feed/internal/domain"
+)
+
+// acquireLockFile creates the lock file exclusively.
+// It fails with domain.ErrCacheLocked while another process holds the lock.
+func acquireLockFile(lockPath string) (*os.File, error) {
+	// Ensure lock file directory exists
+	lockDir := filepath.Dir(lockPath)
+	if _, err := os.Stat(lockDir); os.IsNotExist(err) {
+		slog.Debug("Creating lock file directory", "dir", lockDir)
+		if err := os.MkdirAll(lockDir, 0755); err != nil {
+			return nil, fmt.Errorf("failed to create lock file directory: %w", err)
+		}
+	}
+
+	lockFile, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+	if err != nil {
+		if os.IsExist(err) {
+			return nil, fmt.Errorf("%w: lock file exists at %s. If no other ai-feed process is running, manually delete the lock file", domain.ErrCacheLocked, lockPath)
+		}
+		return nil, fmt.Errorf("failed to create lock file: %w", err)
+	}
+
+	slog.Debug("Acquired cache lock", "lock_path", lockPath)
+	return lockFile, nil
+}
+
+// releaseLockFile closes and removes the lock file
+func releaseLockFile(lockFile *os.File, lockPath string) error {
+	_ = lockFile.Close()
+	if err := os.Remove(lockPath); err != nil && !os.IsNotExist(err) {
+		slog.Warn("Failed to remove lock file", "lock_path", lockPath, "error", err.Error())
+		return err
+	}
+	slog.Debug("Released cache lock", "lock_path", lockPath)
+	return nil
+}
@@ -1,6 +1,11 @@
 package cache
 
-import "github.com/canpok1/ai-feed/internal/domain"
+import (
+	"time"
+
+	"github.com/canpok1/ai-feed/internal/domain"
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+)
 
 // NopCache is a no-operation implementation of RecommendCache interface.
 // It implements the Null Object pattern, doing nothing for all operations.
@@ -22,7 +27,17 @@ func (n *NopCache) IsCached(url string) bool {
 }
 
 // AddEntry does nothing for NopCache
-func (n *NopCache) AddEntry(url, title string) error {
+func (n *NopCache) AddEntry(article entity.Article) error {
+	return nil
+}
+
+// LastRecommendedAt always returns false for NopCache
+func (n *NopCache) LastRecommendedAt(feedURL string) (time.Time, bool) {
+	return time.Time{}, false
+}
+
+// RecentEntries always returns no entries for NopCache
+func (n *NopCache) RecentEntries(since time.Time) []domain.RecommendEntry {
 	return nil
 }
 
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/canpok1/ai-feed/internal/domain"
+	"github.com/canpok1/ai-feed/internal/domain/entity"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -64,7 +65,7 @@ func TestNopCache_AddEntry(t *testing.T) {
 
 		for _, tc := range testCases {
 			t.Run(tc.name, func(t *testing.T) {
-				err := cache.AddEntry(tc.url, tc.title)
+				err := cache.AddEntry(entity.Article{Link: tc.url, Title: tc.title})
 				assert.NoError(t, err)
 			})
 		}
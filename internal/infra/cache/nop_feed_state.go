@@ -0,0 +1,35 @@
+package cache
+
+import "github.com/canpok1/ai-feed/internal/domain"
+
+// NopFeedStateStore is a no-operation implementation of FeedStateStore interface.
+// It never remembers any state, so every fetch becomes an unconditional request.
+type NopFeedStateStore struct{}
+
+// NewNopFeedStateStore creates a new NopFeedStateStore instance
+func NewNopFeedStateStore() *NopFeedStateStore {
+	return &NopFeedStateStore{}
+}
+
+// Initialize does nothing for NopFeedStateStore
+func (n *NopFeedStateStore) Initialize() error {
+	return nil
+}
+
+// Get always returns false for NopFeedStateStore
+func (n *NopFeedStateStore) Get(url string) (domain.FeedState, bool) {
+	return domain.FeedState{}, false
+}
+
+// Update does nothing for NopFeedStateStore
+func (n *NopFeedStateStore) Update(state domain.FeedState) error {
+	return nil
+}
+
+// Close does nothing for NopFeedStateStore
+func (n *NopFeedStateStore) Close() error {
+	return nil
+}
+
+// Verify that NopFeedStateStore implements FeedStateStore interface
+var _ domain.FeedStateStore = (*NopFeedStateStore)(nil)
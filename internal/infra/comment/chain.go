@@ -0,0 +1,25 @@
+package comment
+
+import (
+	"context"
+
+	"github.com/canpok1/ai-feed/internal/domain"
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"github.com/canpok1/ai-feed/internal/infra/llm"
+)
+
+// chainFallbackComment はAIの呼び出し順のmockでコメントが設定されていない場合に使うコメント
+// AIのコメントではないことが読み手に分かるようにする
+const chainFallbackComment = "（AIによるコメントを生成できなかったため、記事のみ紹介します）"
+
+// chainCommentGenerator はAIの呼び出し順（chain）のプロバイダで順にコメントを生成し、最初に成功した結果を返す
+type chainCommentGenerator struct {
+	generators []llm.ChainEntry[domain.CommentGenerator]
+}
+
+// Generate は呼び出し順にコメントを生成する（すべて失敗した場合は最後のエラーを返す）
+func (g *chainCommentGenerator) Generate(ctx context.Context, article *entity.Article) (string, error) {
+	return llm.CallChain(ctx, "comment generation", g.generators, func(generator domain.CommentGenerator) (string, error) {
+		return generator.Generate(ctx, article)
+	})
+}
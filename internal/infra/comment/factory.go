@@ -2,10 +2,10 @@ package comment
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/canpok1/ai-feed/internal/domain"
 	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"github.com/canpok1/ai-feed/internal/infra/llm"
 )
 
 type CommentGeneratorFactory struct{}
@@ -27,15 +27,50 @@ func (f *CommentGeneratorFactory) MakeCommentGenerator(model *entity.AIConfig, p
 		return newMockCommentGenerator(model.Mock.Comment), nil
 	}
 
-	// Gemini設定のバリデーション
-	if model.Gemini == nil {
-		return nil, fmt.Errorf("gemini config is nil")
+	// 呼び出し順が設定されている場合は、失敗したときに次のプロバイダでコメントを生成する
+	if len(model.Chain) > 0 {
+		return f.makeChainCommentGenerator(model, prompt)
 	}
-	if result := model.Gemini.Validate(); !result.IsValid {
-		return nil, fmt.Errorf("invalid gemini config: %s", strings.Join(result.Errors, "; "))
+
+	// それ以外はAI設定のプロバイダのLLMクライアントでコメントを生成する
+	// モデルの使用可否判定は各プロバイダに任せる
+	client, err := llm.NewClient(model)
+	if err != nil {
+		return nil, err
 	}
+	return newLLMCommentGenerator(client, prompt, prompt.SystemPrompt), nil
+}
+
+// makeChainCommentGenerator は呼び出し順のプロバイダのコメント生成器を順に試すCommentGeneratorを生成する
+// 設定がない、または不正なプロバイダは飛ばす
+func (f *CommentGeneratorFactory) makeChainCommentGenerator(model *entity.AIConfig, prompt *entity.PromptConfig) (domain.CommentGenerator, error) {
+	generators, err := llm.BuildChain("comment generation", model.Chain, func(provider string) (domain.CommentGenerator, error) {
+		return f.makeProviderCommentGenerator(provider, model, prompt)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &chainCommentGenerator{generators: generators}, nil
+}
 
-	// すべてのGeminiモデルをサポート
-	// モデルの使用可否判定はGeminiライブラリに任せる
-	return newGeminiCommentGenerator(model, prompt, prompt.SystemPrompt)
+// makeProviderCommentGenerator は指定したプロバイダのコメント生成器を生成する
+func (f *CommentGeneratorFactory) makeProviderCommentGenerator(name string, model *entity.AIConfig, prompt *entity.PromptConfig) (domain.CommentGenerator, error) {
+	// mockは設定がなくても使えるように、コメントの省略時はAIのコメントではないことを示すコメントにする
+	if name == "mock" {
+		comment := chainFallbackComment
+		if model.Mock != nil && model.Mock.Comment != "" {
+			comment = model.Mock.Comment
+		}
+		return newMockCommentGenerator(comment), nil
+	}
+
+	provider, ok := llm.Lookup(name)
+	if !ok {
+		return nil, fmt.Errorf("unsupported AI provider: %s", name)
+	}
+	client, err := provider.NewClient(model)
+	if err != nil {
+		return nil, err
+	}
+	return newLLMCommentGenerator(client, prompt, prompt.SystemPrompt), nil
 }
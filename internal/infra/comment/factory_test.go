@@ -1,6 +1,7 @@
 package comment
 
 import (
+	"context"
 	"testing"
 
 	"github.com/canpok1/ai-feed/internal/domain/entity"
@@ -41,7 +42,7 @@ func TestCommentGeneratorFactory_MakeCommentGenerator(t *testing.T) {
 			},
 			prompt:    &entity.PromptConfig{SystemPrompt: "test"},
 			wantErr:   true,
-			errString: "gemini config is nil",
+			errString: "no supported AI configuration found",
 		},
 		{
 			name: "異常系_Geminiモデルタイプが空文字列",
@@ -67,6 +68,41 @@ func TestCommentGeneratorFactory_MakeCommentGenerator(t *testing.T) {
 			wantErr:   true,
 			errString: "Gemini APIキーが設定されていません",
 		},
+		{
+			name: "異常系_OpenAIのモデル名が空",
+			model: &entity.AIConfig{
+				OpenAI: &entity.OpenAIConfig{BaseURL: "http://localhost:11434/v1"},
+			},
+			prompt:    &entity.PromptConfig{SystemPrompt: "test"},
+			wantErr:   true,
+			errString: "OpenAI設定のモデル名が設定されていません",
+		},
+		{
+			name: "正常系_OpenAI互換APIはGemini設定より優先する",
+			model: &entity.AIConfig{
+				Gemini: nil,
+				OpenAI: &entity.OpenAIConfig{BaseURL: "http://localhost:11434/v1", Model: "llama3.1"},
+			},
+			prompt:  &entity.PromptConfig{SystemPrompt: "test"},
+			wantErr: false,
+		},
+		{
+			name: "異常系_Ollamaのモデル名が空",
+			model: &entity.AIConfig{
+				Ollama: &entity.OllamaConfig{},
+			},
+			prompt:    &entity.PromptConfig{SystemPrompt: "test"},
+			wantErr:   true,
+			errString: "Ollama設定のモデル名が設定されていません",
+		},
+		{
+			name: "正常系_Ollamaの設定がある場合はGemini設定は不要",
+			model: &entity.AIConfig{
+				Ollama: &entity.OllamaConfig{Model: "llama3.1"},
+			},
+			prompt:  &entity.PromptConfig{SystemPrompt: "test"},
+			wantErr: false,
+		},
 		{
 			name: "正常系_任意のGeminiモデル名_gemini-1.5-pro",
 			model: &entity.AIConfig{
@@ -137,3 +173,70 @@ func TestCommentGeneratorFactory_MakeCommentGenerator(t *testing.T) {
 		})
 	}
 }
+
+func TestCommentGeneratorFactory_MakeCommentGenerator_Chain(t *testing.T) {
+	article := &entity.Article{Title: "記事", Link: "https://example.com/1"}
+	prompt := &entity.PromptConfig{SystemPrompt: "test"}
+
+	tests := []struct {
+		name          string
+		model         *entity.AIConfig
+		wantProviders []string
+		wantComment   string
+		errString     string
+	}{
+		{
+			name: "正常系_APIキーのないプロバイダは飛ばしてmockのコメントを使う",
+			model: &entity.AIConfig{
+				Gemini: &entity.GeminiConfig{Type: "gemini-2.5-flash"},
+				Chain:  []string{"gemini", "openai", "mock"},
+			},
+			wantProviders: []string{"mock"},
+			wantComment:   chainFallbackComment,
+		},
+		{
+			name: "正常系_mockの固定コメントを使う",
+			model: &entity.AIConfig{
+				Ollama: &entity.OllamaConfig{Model: "llama3.1"},
+				Mock:   &entity.MockConfig{Comment: "固定コメント"},
+				Chain:  []string{"ollama", "mock"},
+			},
+			wantProviders: []string{"ollama", "mock"},
+			wantComment:   "固定コメント",
+		},
+		{
+			name: "異常系_使用できるプロバイダがない",
+			model: &entity.AIConfig{
+				OpenAI: &entity.OpenAIConfig{},
+				Chain:  []string{"openai"},
+			},
+			errString: "no usable AI provider in chain: openai",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			generator, err := NewCommentGeneratorFactory().MakeCommentGenerator(tt.model, prompt)
+			if tt.errString != "" {
+				assert.EqualError(t, err, tt.errString)
+				return
+			}
+			assert.NoError(t, err)
+
+			chain, ok := generator.(*chainCommentGenerator)
+			if !assert.True(t, ok) {
+				return
+			}
+			var providers []string
+			for _, entry := range chain.generators {
+				providers = append(providers, entry.Provider)
+			}
+			assert.Equal(t, tt.wantProviders, providers)
+
+			// 末尾のmockで生成したコメントを確認する
+			comment, err := chain.generators[len(chain.generators)-1].Value.Generate(context.Background(), article)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantComment, comment)
+		})
+	}
+}
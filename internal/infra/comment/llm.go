@@ -0,0 +1,45 @@
+package comment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/canpok1/ai-feed/internal/domain"
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"github.com/canpok1/ai-feed/internal/infra/content"
+)
+
+// llmCommentGenerator はdomain.LLMClientを使用したコメント生成の実装
+type llmCommentGenerator struct {
+	client       domain.LLMClient
+	prompt       *entity.PromptConfig
+	systemPrompt string
+}
+
+func newLLMCommentGenerator(client domain.LLMClient, prompt *entity.PromptConfig, systemPrompt string) domain.CommentGenerator {
+	return &llmCommentGenerator{
+		client:       client,
+		prompt:       prompt,
+		systemPrompt: systemPrompt,
+	}
+}
+
+func (g *llmCommentGenerator) Generate(ctx context.Context, article *entity.Article) (string, error) {
+	prompt, err := buildCommentPrompt(g.prompt, article)
+	if err != nil {
+		return "", fmt.Errorf("プロンプト生成エラー: %w", err)
+	}
+
+	text, err := g.client.GenerateText(ctx, domain.LLMRequest{SystemPrompt: g.systemPrompt, Prompt: prompt})
+	if err != nil {
+		return "", fmt.Errorf("failed to generate content: %w", err)
+	}
+	return text, nil
+}
+
+// buildCommentPrompt は記事本文をプレーンテキストに変換して上限までに切り詰めてから、コメントプロンプトを生成する
+func buildCommentPrompt(prompt *entity.PromptConfig, article *entity.Article) (string, error) {
+	normalized := *article
+	normalized.Content = content.Prepare(article.Content, prompt.CommentContentBudgetOrDefault())
+	return prompt.BuildCommentPrompt(&normalized)
+}
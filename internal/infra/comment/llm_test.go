@@ -0,0 +1,46 @@
+package comment
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/canpok1/ai-feed/internal/domain"
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"github.com/canpok1/ai-feed/internal/domain/mock_domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestBuildCommentPrompt(t *testing.T) {
+	prompt := &entity.PromptConfig{
+		CommentPromptTemplate: "{{TITLE}}\n{{CONTENT}}",
+		CommentContentBudget:  entity.ContentBudget{MaxChars: 20},
+	}
+	article := &entity.Article{
+		Title:   "記事タイトル",
+		Content: "<div><p>本文の&quot;最初&quot;の段落</p><style>p{}</style><p>" + strings.Repeat("長い本文", 10) + "</p></div>",
+	}
+
+	result, err := buildCommentPrompt(prompt, article)
+	require.NoError(t, err)
+
+	assert.Equal(t, "記事タイトル\n本文の\"最初\"の段落\n長い本文長い本文長…", result)
+	// 元の記事の本文は変更しない
+	assert.Contains(t, article.Content, "<div>")
+}
+
+func TestLLMCommentGenerator_Generate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mock_domain.NewMockLLMClient(ctrl)
+	client.EXPECT().
+		GenerateText(gomock.Any(), domain.LLMRequest{SystemPrompt: "system", Prompt: "記事"}).
+		Return("面白い記事です", nil)
+
+	generator := newLLMCommentGenerator(client, &entity.PromptConfig{CommentPromptTemplate: "{{TITLE}}"}, "system")
+
+	comment, err := generator.Generate(context.Background(), &entity.Article{Title: "記事"})
+	require.NoError(t, err)
+	assert.Equal(t, "面白い記事です", comment)
+}
@@ -2,9 +2,12 @@ package infra
 
 import (
 	"fmt"
+	"log/slog"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/canpok1/ai-feed/internal/domain"
 	"github.com/canpok1/ai-feed/internal/domain/entity"
@@ -17,9 +20,19 @@ type Config struct {
 }
 
 type Profile struct {
-	AI     *AIConfig     `yaml:"ai,omitempty"`
-	Prompt *PromptConfig `yaml:",inline,omitempty"`
-	Output *OutputConfig `yaml:"output,omitempty"`
+	AI               *AIConfig               `yaml:"ai,omitempty"`
+	Prompt           *PromptConfig           `yaml:",inline,omitempty"`
+	Output           *OutputConfig           `yaml:"output,omitempty"`
+	Fetch            *FetchConfig            `yaml:"fetch,omitempty"`
+	FeedSelection    *FeedSelectionConfig    `yaml:"feed_selection,omitempty"`
+	FeedHealth       *FeedHealthConfig       `yaml:"feed_health,omitempty"`
+	Filters          *FilterConfig           `yaml:"filters,omitempty"`
+	FullText         *FullTextConfig         `yaml:"full_text,omitempty"`
+	CanonicalURL     *CanonicalURLConfig     `yaml:"canonical_url,omitempty"`
+	Dedup            *DedupConfig            `yaml:"dedup,omitempty"`
+	TopicSuppression *TopicSuppressionConfig `yaml:"topic_suppression,omitempty"`
+	Feeds            []FeedConfig            `yaml:"feeds,omitempty"`
+	Seed             *uint64                 `yaml:"seed,omitempty"`
 }
 
 // ToEntity converts infra.Profile to entity.Profile
@@ -47,16 +60,290 @@ func (p *Profile) ToEntity() (*entity.Profile, error) {
 		}
 	}
 
+	var fetchEntity *entity.FetchConfig
+	if p.Fetch != nil {
+		var err error
+		fetchEntity, err = p.Fetch.ToEntity()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var feedSelectionEntity *entity.FeedSelectionConfig
+	if p.FeedSelection != nil {
+		feedSelectionEntity = p.FeedSelection.ToEntity()
+	}
+
+	var feedHealthEntity *entity.FeedHealthConfig
+	if p.FeedHealth != nil {
+		var err error
+		feedHealthEntity, err = p.FeedHealth.ToEntity()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var filtersEntity *entity.FilterConfig
+	if p.Filters != nil {
+		var err error
+		filtersEntity, err = p.Filters.ToEntity()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var fullTextEntity *entity.FullTextConfig
+	if p.FullText != nil {
+		fullTextEntity = p.FullText.ToEntity()
+	}
+
+	var canonicalURLEntity *entity.CanonicalURLConfig
+	if p.CanonicalURL != nil {
+		canonicalURLEntity = p.CanonicalURL.ToEntity()
+	}
+
+	var dedupEntity *entity.DedupConfig
+	if p.Dedup != nil {
+		dedupEntity = p.Dedup.ToEntity()
+	}
+
+	var topicSuppressionEntity *entity.TopicSuppressionConfig
+	if p.TopicSuppression != nil {
+		var err error
+		topicSuppressionEntity, err = p.TopicSuppression.ToEntity()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	feeds, err := feedConfigsToEntities(p.Feeds)
+	if err != nil {
+		return nil, err
+	}
+
 	return &entity.Profile{
-		AI:     aiEntity,
-		Prompt: promptEntity,
-		Output: outputEntity,
+		AI:               aiEntity,
+		Prompt:           promptEntity,
+		Output:           outputEntity,
+		Fetch:            fetchEntity,
+		FeedSelection:    feedSelectionEntity,
+		FeedHealth:       feedHealthEntity,
+		Filters:          filtersEntity,
+		FullText:         fullTextEntity,
+		CanonicalURL:     canonicalURLEntity,
+		Dedup:            dedupEntity,
+		TopicSuppression: topicSuppressionEntity,
+		Feeds:            feeds,
+		Seed:             p.Seed,
+	}, nil
+}
+
+// FeedSelectionConfig は推薦元フィードの選択方法の設定
+type FeedSelectionConfig struct {
+	Strategy string `yaml:"strategy,omitempty"` // random, weighted, round_robin, least_recent, all
+}
+
+func (c *FeedSelectionConfig) ToEntity() *entity.FeedSelectionConfig {
+	return &entity.FeedSelectionConfig{
+		Strategy: strings.ToLower(strings.TrimSpace(c.Strategy)),
+	}
+}
+
+// FeedHealthConfig はフィードの健全性記録と自動除外の設定
+type FeedHealthConfig struct {
+	Enabled          *bool  `yaml:"enabled,omitempty"`
+	FailureThreshold int    `yaml:"failure_threshold,omitempty"`
+	Cooldown         string `yaml:"cooldown,omitempty"` // 例: "12h", "30m"
+}
+
+func (c *FeedHealthConfig) ToEntity() (*entity.FeedHealthConfig, error) {
+	var cooldown time.Duration
+	if c.Cooldown != "" {
+		var err error
+		cooldown, err = time.ParseDuration(c.Cooldown)
+		if err != nil {
+			return nil, fmt.Errorf("feed_health.cooldown の形式が不正です（例: 12h, 30m）: %s", c.Cooldown)
+		}
+	}
+
+	return &entity.FeedHealthConfig{
+		Enabled:          c.Enabled,
+		FailureThreshold: c.FailureThreshold,
+		Cooldown:         cooldown,
+	}, nil
+}
+
+// FullTextConfig は本文が短い記事について、記事ページから本文を取得する設定
+type FullTextConfig struct {
+	Enabled          *bool `yaml:"enabled,omitempty"`
+	MinContentLength int   `yaml:"min_content_length,omitempty"`
+}
+
+func (c *FullTextConfig) ToEntity() *entity.FullTextConfig {
+	return &entity.FullTextConfig{
+		Enabled:          c.Enabled,
+		MinContentLength: c.MinContentLength,
+	}
+}
+
+// CanonicalURLConfig は記事URLの正規化の設定
+type CanonicalURLConfig struct {
+	StripParams      []string `yaml:"strip_params,omitempty"`
+	FollowRedirects  *bool    `yaml:"follow_redirects,omitempty"`
+	UseCanonicalLink *bool    `yaml:"use_canonical_link,omitempty"`
+	RewriteAMPPaths  *bool    `yaml:"rewrite_amp_paths,omitempty"`
+}
+
+func (c *CanonicalURLConfig) ToEntity() *entity.CanonicalURLConfig {
+	return &entity.CanonicalURLConfig{
+		StripParams:      c.StripParams,
+		FollowRedirects:  c.FollowRedirects,
+		UseCanonicalLink: c.UseCanonicalLink,
+		RewriteAMPPaths:  c.RewriteAMPPaths,
+	}
+}
+
+// DedupConfig は複数のフィードから取得した重複記事をまとめる設定
+type DedupConfig struct {
+	Enabled         *bool   `yaml:"enabled,omitempty"`
+	TitleSimilarity float64 `yaml:"title_similarity,omitempty"`
+	Keep            string  `yaml:"keep,omitempty"` // richest, earliest
+}
+
+func (c *DedupConfig) ToEntity() *entity.DedupConfig {
+	return &entity.DedupConfig{
+		Enabled:         c.Enabled,
+		TitleSimilarity: c.TitleSimilarity,
+		Keep:            c.Keep,
+	}
+}
+
+// TopicSuppressionConfig は過去に推薦した記事と同じ話題の記事を抑制する設定
+type TopicSuppressionConfig struct {
+	Enabled    *bool   `yaml:"enabled,omitempty"`
+	Similarity float64 `yaml:"similarity,omitempty"`
+	Window     string  `yaml:"window,omitempty"` // 例: "168h"
+	Action     string  `yaml:"action,omitempty"` // drop, demote
+}
+
+func (c *TopicSuppressionConfig) ToEntity() (*entity.TopicSuppressionConfig, error) {
+	var window time.Duration
+	if c.Window != "" {
+		var err error
+		window, err = time.ParseDuration(c.Window)
+		if err != nil {
+			return nil, fmt.Errorf("topic_suppression.window の形式が不正です（例: 168h, 72h）: %s", c.Window)
+		}
+	}
+
+	return &entity.TopicSuppressionConfig{
+		Enabled:    c.Enabled,
+		Similarity: c.Similarity,
+		Window:     window,
+		Action:     c.Action,
+	}, nil
+}
+
+// FilterConfig は推薦候補の記事を絞り込むルールの設定
+type FilterConfig struct {
+	IncludeKeywords  []string `yaml:"include_keywords,omitempty"`
+	ExcludeKeywords  []string `yaml:"exclude_keywords,omitempty"`
+	IncludePatterns  []string `yaml:"include_patterns,omitempty"`
+	ExcludePatterns  []string `yaml:"exclude_patterns,omitempty"`
+	Fields           []string `yaml:"fields,omitempty"` // title, content, url
+	AllowCategories  []string `yaml:"allow_categories,omitempty"`
+	DenyCategories   []string `yaml:"deny_categories,omitempty"`
+	BlockedDomains   []string `yaml:"blocked_domains,omitempty"`
+	MinContentLength int      `yaml:"min_content_length,omitempty"`
+	MaxAge           string   `yaml:"max_age,omitempty"` // 例: "72h", "168h"
+}
+
+func (c *FilterConfig) ToEntity() (*entity.FilterConfig, error) {
+	var maxAge time.Duration
+	if c.MaxAge != "" {
+		var err error
+		maxAge, err = time.ParseDuration(c.MaxAge)
+		if err != nil {
+			return nil, fmt.Errorf("filters.max_age の形式が不正です（例: 72h, 30m）: %s", c.MaxAge)
+		}
+	}
+
+	var fields []string
+	for _, field := range c.Fields {
+		fields = append(fields, strings.ToLower(strings.TrimSpace(field)))
+	}
+
+	return &entity.FilterConfig{
+		IncludeKeywords:  c.IncludeKeywords,
+		ExcludeKeywords:  c.ExcludeKeywords,
+		IncludePatterns:  c.IncludePatterns,
+		ExcludePatterns:  c.ExcludePatterns,
+		Fields:           fields,
+		AllowCategories:  c.AllowCategories,
+		DenyCategories:   c.DenyCategories,
+		BlockedDomains:   c.BlockedDomains,
+		MinContentLength: c.MinContentLength,
+		MaxAge:           maxAge,
+	}, nil
+}
+
+// FetchConfig はフィード取得時のHTTPクライアント設定
+type FetchConfig struct {
+	UserAgent    string            `yaml:"user_agent,omitempty"`
+	Timeout      string            `yaml:"timeout,omitempty"`  // 例: "30s", "1m"
+	Deadline     string            `yaml:"deadline,omitempty"` // 例: "2m"
+	Concurrency  int               `yaml:"concurrency,omitempty"`
+	ProxyURL     string            `yaml:"proxy_url,omitempty"`
+	Headers      map[string]string `yaml:"headers,omitempty"`
+	CABundlePath string            `yaml:"ca_bundle_path,omitempty"`
+	MaxBodySize  int64             `yaml:"max_body_size,omitempty"`
+	AutoDiscover *bool             `yaml:"auto_discover,omitempty"`
+}
+
+func (c *FetchConfig) ToEntity() (*entity.FetchConfig, error) {
+	var timeout time.Duration
+	if c.Timeout != "" {
+		var err error
+		timeout, err = time.ParseDuration(c.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("fetch.timeout の形式が不正です（例: 30s, 1m）: %s", c.Timeout)
+		}
+	}
+
+	var deadline time.Duration
+	if c.Deadline != "" {
+		var err error
+		deadline, err = time.ParseDuration(c.Deadline)
+		if err != nil {
+			return nil, fmt.Errorf("fetch.deadline の形式が不正です（例: 2m, 90s）: %s", c.Deadline)
+		}
+	}
+
+	caBundlePath, err := expandPath(c.CABundlePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to expand CA bundle path: %w", err)
+	}
+
+	return &entity.FetchConfig{
+		UserAgent:    c.UserAgent,
+		Timeout:      timeout,
+		Deadline:     deadline,
+		Concurrency:  c.Concurrency,
+		ProxyURL:     c.ProxyURL,
+		Headers:      c.Headers,
+		CABundlePath: caBundlePath,
+		MaxBodySize:  c.MaxBodySize,
+		AutoDiscover: c.AutoDiscover,
 	}, nil
 }
 
 type AIConfig struct {
-	Gemini *GeminiConfig `yaml:"gemini,omitempty"`
-	Mock   *MockConfig   `yaml:"mock,omitempty"`
+	Gemini *GeminiConfig  `yaml:"gemini,omitempty"`
+	OpenAI *OpenAIConfig  `yaml:"openai,omitempty"`
+	Ollama *OllamaConfig  `yaml:"ollama,omitempty"`
+	Retry  *AIRetryConfig `yaml:"retry,omitempty"`
+	Mock   *MockConfig    `yaml:"mock,omitempty"`
+	Chain  []string       `yaml:"chain,omitempty"`
 }
 
 // MockConfig はAIのモック設定
@@ -81,21 +368,73 @@ func (c *AIConfig) ToEntity() (*entity.AIConfig, error) {
 	var geminiEntity *entity.GeminiConfig
 	if c.Gemini != nil {
 		var err error
-		geminiEntity, err = c.Gemini.ToEntity()
+		geminiEntity, err = c.Gemini.toEntity(slices.Contains(c.Chain, "gemini"))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var openAIEntity *entity.OpenAIConfig
+	if c.OpenAI != nil {
+		var err error
+		openAIEntity, err = c.OpenAI.toEntity(slices.Contains(c.Chain, "openai"))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var retryEntity *entity.AIRetryConfig
+	if c.Retry != nil {
+		var err error
+		retryEntity, err = c.Retry.ToEntity()
 		if err != nil {
 			return nil, err
 		}
 	}
 	return &entity.AIConfig{
 		Gemini: geminiEntity,
+		OpenAI: openAIEntity,
+		Ollama: c.Ollama.ToEntity(),
+		Retry:  retryEntity,
 		Mock:   c.Mock.ToEntity(),
+		Chain:  c.Chain,
+	}, nil
+}
+
+// AIRetryConfig はAI呼び出しのリトライの設定
+type AIRetryConfig struct {
+	MaxAttempts     int    `yaml:"max_attempts,omitempty"`
+	InitialInterval string `yaml:"initial_interval,omitempty"` // 例: "2s"
+	MaxInterval     string `yaml:"max_interval,omitempty"`     // 例: "30s"
+}
+
+func (c *AIRetryConfig) ToEntity() (*entity.AIRetryConfig, error) {
+	var initialInterval, maxInterval time.Duration
+	if c.InitialInterval != "" {
+		var err error
+		initialInterval, err = time.ParseDuration(c.InitialInterval)
+		if err != nil {
+			return nil, fmt.Errorf("ai.retry.initial_interval の形式が不正です（例: 2s, 500ms）: %s", c.InitialInterval)
+		}
+	}
+	if c.MaxInterval != "" {
+		var err error
+		maxInterval, err = time.ParseDuration(c.MaxInterval)
+		if err != nil {
+			return nil, fmt.Errorf("ai.retry.max_interval の形式が不正です（例: 30s, 1m）: %s", c.MaxInterval)
+		}
+	}
+
+	return &entity.AIRetryConfig{
+		MaxAttempts:     c.MaxAttempts,
+		InitialInterval: initialInterval,
+		MaxInterval:     maxInterval,
 	}, nil
 }
 
 type GeminiConfig struct {
-	Type      string `yaml:"type"`
-	APIKey    string `yaml:"api_key"`
-	APIKeyEnv string `yaml:"api_key_env,omitempty"`
+	Type           string   `yaml:"type"`
+	APIKey         string   `yaml:"api_key"`
+	APIKeyEnv      string   `yaml:"api_key_env,omitempty"`
+	FallbackModels []string `yaml:"fallback_models,omitempty"`
 }
 
 // resolveSecret は、直接指定された値または環境変数から値を解決する
@@ -122,6 +461,18 @@ func resolveSecretString(value, envVar, configPath string) (entity.SecretString,
 	return entity.NewSecretString(str), nil
 }
 
+// resolveChainSecretString は、AIのAPIキーを値または環境変数から解決する
+// AIの呼び出し順（chain）に含まれるプロバイダの場合は、環境変数が設定されていなくてもエラーにせず、
+// APIキーを空にして実行時に次のプロバイダへ切り替えられるようにする
+func resolveChainSecretString(value, envVar, configPath string, inChain bool) (entity.SecretString, error) {
+	secret, err := resolveSecretString(value, envVar, configPath)
+	if err != nil && inChain {
+		slog.Warn("API key for AI provider in chain is not set; the provider will be skipped", "config", configPath, "error", err)
+		return entity.SecretString{}, nil
+	}
+	return secret, err
+}
+
 // resolveEnabled は、Enabledフィールドのデフォルト値処理を行う（後方互換性のために保持）
 func resolveEnabled(e *bool) bool {
 	if e == nil {
@@ -140,31 +491,116 @@ func resolveEnabledPtr(e *bool) *bool {
 }
 
 func (c *GeminiConfig) ToEntity() (*entity.GeminiConfig, error) {
-	apiKey, err := resolveSecretString(c.APIKey, c.APIKeyEnv, "ai.gemini.api_key_env")
+	return c.toEntity(false)
+}
+
+// toEntity はinChainがtrueの場合、APIキーの環境変数が設定されていなくてもエラーにしない
+func (c *GeminiConfig) toEntity(inChain bool) (*entity.GeminiConfig, error) {
+	apiKey, err := resolveChainSecretString(c.APIKey, c.APIKeyEnv, "ai.gemini.api_key_env", inChain)
 	if err != nil {
 		return nil, err
 	}
 
 	return &entity.GeminiConfig{
-		Type:   c.Type,
-		APIKey: apiKey,
+		Type:           c.Type,
+		APIKey:         apiKey,
+		FallbackModels: c.FallbackModels,
 	}, nil
 }
 
+// OpenAIConfig はOpenAI互換のChat Completions APIの設定
+type OpenAIConfig struct {
+	BaseURL        string   `yaml:"base_url,omitempty"`
+	Model          string   `yaml:"model"`
+	APIKey         string   `yaml:"api_key,omitempty"`
+	APIKeyEnv      string   `yaml:"api_key_env,omitempty"`
+	JSONMode       *bool    `yaml:"json_mode,omitempty"`
+	FallbackModels []string `yaml:"fallback_models,omitempty"`
+}
+
+func (c *OpenAIConfig) ToEntity() (*entity.OpenAIConfig, error) {
+	return c.toEntity(false)
+}
+
+// toEntity はinChainがtrueの場合、APIキーの環境変数が設定されていなくてもエラーにしない
+func (c *OpenAIConfig) toEntity(inChain bool) (*entity.OpenAIConfig, error) {
+	apiKey, err := resolveChainSecretString(c.APIKey, c.APIKeyEnv, "ai.openai.api_key_env", inChain)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.OpenAIConfig{
+		BaseURL:        strings.TrimSpace(c.BaseURL),
+		Model:          c.Model,
+		APIKey:         apiKey,
+		JSONMode:       c.JSONMode,
+		FallbackModels: c.FallbackModels,
+	}, nil
+}
+
+// OllamaConfig はOllamaのネイティブAPIの設定
+type OllamaConfig struct {
+	BaseURL        string   `yaml:"base_url,omitempty"`
+	Model          string   `yaml:"model"`
+	KeepAlive      string   `yaml:"keep_alive,omitempty"`
+	FallbackModels []string `yaml:"fallback_models,omitempty"`
+}
+
+func (c *OllamaConfig) ToEntity() *entity.OllamaConfig {
+	if c == nil {
+		return nil
+	}
+	return &entity.OllamaConfig{
+		BaseURL:        strings.TrimSpace(c.BaseURL),
+		Model:          c.Model,
+		KeepAlive:      strings.TrimSpace(c.KeepAlive),
+		FallbackModels: c.FallbackModels,
+	}
+}
+
 type PromptConfig struct {
-	SystemPrompt          string `yaml:"system_prompt,omitempty"`
-	CommentPromptTemplate string `yaml:"comment_prompt_template,omitempty"`
-	SelectorPrompt        string `yaml:"selector_prompt,omitempty"`
-	FixedMessage          string `yaml:"fixed_message,omitempty"`
+	SystemPrompt          string               `yaml:"system_prompt,omitempty"`
+	CommentPromptTemplate string               `yaml:"comment_prompt_template,omitempty"`
+	SelectorPrompt        string               `yaml:"selector_prompt,omitempty"`
+	FixedMessage          string               `yaml:"fixed_message,omitempty"`
+	ContentBudget         *ContentBudgetConfig `yaml:"content_budget,omitempty"`
 }
 
 func (c *PromptConfig) ToEntity() *entity.PromptConfig {
-	return &entity.PromptConfig{
+	prompt := &entity.PromptConfig{
 		SystemPrompt:          c.SystemPrompt,
 		CommentPromptTemplate: c.CommentPromptTemplate,
 		SelectorPrompt:        c.SelectorPrompt,
 		FixedMessage:          c.FixedMessage,
 	}
+	if c.ContentBudget != nil {
+		prompt.SelectorContentBudget = c.ContentBudget.Selector.ToEntity()
+		prompt.CommentContentBudget = c.ContentBudget.Comment.ToEntity()
+	}
+	return prompt
+}
+
+// ContentBudgetConfig はプロンプトに含める記事本文の上限をプロンプトの種類ごとに設定する
+type ContentBudgetConfig struct {
+	Selector *ContentBudget `yaml:"selector,omitempty"`
+	Comment  *ContentBudget `yaml:"comment,omitempty"`
+}
+
+// ContentBudget はプロンプトに含める記事本文の上限の設定
+type ContentBudget struct {
+	MaxChars  int `yaml:"max_chars,omitempty"`
+	MaxTokens int `yaml:"max_tokens,omitempty"`
+}
+
+// ToEntity converts infra.ContentBudget to entity.ContentBudget (nilの場合はゼロ値)
+func (c *ContentBudget) ToEntity() entity.ContentBudget {
+	if c == nil {
+		return entity.ContentBudget{}
+	}
+	return entity.ContentBudget{
+		MaxChars:  c.MaxChars,
+		MaxTokens: c.MaxTokens,
+	}
 }
 
 type OutputConfig struct {
@@ -384,12 +820,13 @@ func (r *YamlConfigRepository) Load() (*domain.LoadedConfig, error) {
 	}
 
 	if infraConfig.Cache != nil {
-		result.Cache = &entity.CacheConfig{
-			Enabled:       resolveCacheEnabledPtr(infraConfig.Cache.Enabled),
-			FilePath:      infraConfig.Cache.FilePath,
-			MaxEntries:    infraConfig.Cache.MaxEntries,
-			RetentionDays: infraConfig.Cache.RetentionDays,
+		// パスの展開とデフォルト値の設定はToEntityと共通にする
+		// （file_pathを省略した場合も、フィードの取得状態と記事本文はデフォルトのキャッシュディレクトリに保存する）
+		cacheConfig, err := infraConfig.Cache.ToEntity()
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert cache config: %w", err)
 		}
+		result.Cache = cacheConfig
 	}
 
 	return result, nil
@@ -400,6 +837,34 @@ type CacheConfig struct {
 	FilePath      string `yaml:"file_path,omitempty"`
 	MaxEntries    int    `yaml:"max_entries,omitempty"`
 	RetentionDays int    `yaml:"retention_days,omitempty"`
+	// FeedStateFilePath はフィードの取得状態ファイルのパス（省略時はキャッシュファイルと同じディレクトリのfeed_state.json）
+	FeedStateFilePath string `yaml:"feed_state_file_path,omitempty"`
+	// ArticleCacheDir は記事ページから取得した本文の保存先（省略時はキャッシュファイルと同じディレクトリのarticles）
+	ArticleCacheDir string `yaml:"article_cache_dir,omitempty"`
+}
+
+// feedStateFileName はフィードの取得状態ファイルのデフォルトのファイル名
+const feedStateFileName = "feed_state.json"
+
+// articleCacheDirName は記事本文の保存先のデフォルトのディレクトリ名
+const articleCacheDirName = "articles"
+
+// resolveArticleCacheDir は、記事本文の保存先を決定する
+// 未指定の場合はキャッシュファイルと同じディレクトリに配置する
+func resolveArticleCacheDir(articleCacheDir, cacheFilePath string) string {
+	if articleCacheDir != "" || cacheFilePath == "" {
+		return articleCacheDir
+	}
+	return filepath.Join(filepath.Dir(cacheFilePath), articleCacheDirName)
+}
+
+// resolveFeedStateFilePath は、フィードの取得状態ファイルのパスを決定する
+// 未指定の場合はキャッシュファイルと同じディレクトリに配置する
+func resolveFeedStateFilePath(feedStatePath, cacheFilePath string) string {
+	if feedStatePath != "" || cacheFilePath == "" {
+		return feedStatePath
+	}
+	return filepath.Join(filepath.Dir(cacheFilePath), feedStateFileName)
 }
 
 // resolveCacheEnabled は、Enabledフィールドのデフォルト値処理を行う（キャッシュのデフォルトはfalse）（後方互換性のために保持）
@@ -475,11 +940,23 @@ func (c *CacheConfig) ToEntity() (*entity.CacheConfig, error) {
 		retentionDays = 30
 	}
 
+	feedStatePath, err := expandPath(resolveFeedStateFilePath(c.FeedStateFilePath, expandedPath))
+	if err != nil {
+		return nil, fmt.Errorf("failed to expand feed state file path: %w", err)
+	}
+
+	articleCacheDir, err := expandPath(resolveArticleCacheDir(c.ArticleCacheDir, expandedPath))
+	if err != nil {
+		return nil, fmt.Errorf("failed to expand article cache directory: %w", err)
+	}
+
 	return &entity.CacheConfig{
-		Enabled:       enabledPtr,
-		FilePath:      expandedPath,
-		MaxEntries:    maxEntries,
-		RetentionDays: retentionDays,
+		Enabled:           enabledPtr,
+		FilePath:          expandedPath,
+		MaxEntries:        maxEntries,
+		RetentionDays:     retentionDays,
+		FeedStateFilePath: feedStatePath,
+		ArticleCacheDir:   articleCacheDir,
 	}, nil
 }
 
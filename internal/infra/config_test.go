@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/canpok1/ai-feed/internal/domain/entity"
 	"github.com/canpok1/ai-feed/internal/testutil"
@@ -108,6 +109,83 @@ func TestYamlConfigRepository_Load_InvalidYaml(t *testing.T) {
 	assert.Contains(t, err.Error(), "failed to unmarshal YAML")
 }
 
+func TestYamlConfigRepository_Load_ArticleCacheDir(t *testing.T) {
+	homeDir, err := os.UserHomeDir()
+	assert.NoError(t, err)
+	tmpDir := t.TempDir()
+
+	tests := []struct {
+		name     string
+		yaml     string
+		expected string
+	}{
+		{
+			name:     "未指定の場合はキャッシュファイルと同じディレクトリ",
+			yaml:     "cache:\n  enabled: true\n  file_path: " + filepath.Join(tmpDir, "recommend_history.jsonl") + "\n",
+			expected: filepath.Join(tmpDir, "articles"),
+		},
+		{
+			name:     "指定したディレクトリのチルダを展開する",
+			yaml:     "cache:\n  enabled: true\n  article_cache_dir: ~/.ai-feed/pages\n",
+			expected: filepath.Join(homeDir, ".ai-feed", "pages"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filePath := filepath.Join(t.TempDir(), "config.yml")
+			assert.NoError(t, os.WriteFile(filePath, []byte(tt.yaml), 0644))
+
+			config, err := NewYamlConfigRepository(filePath).Load()
+			assert.NoError(t, err)
+			if assert.NotNil(t, config.Cache) {
+				assert.Equal(t, tt.expected, config.Cache.ArticleCacheDir)
+			}
+		})
+	}
+}
+
+func TestYamlConfigRepository_Load_FeedStateFilePath(t *testing.T) {
+	homeDir, err := os.UserHomeDir()
+	assert.NoError(t, err)
+	tmpDir := t.TempDir()
+
+	tests := []struct {
+		name     string
+		yaml     string
+		expected string
+	}{
+		{
+			name:     "未指定の場合はキャッシュファイルと同じディレクトリ",
+			yaml:     "cache:\n  enabled: true\n  file_path: " + filepath.Join(tmpDir, "recommend_history.jsonl") + "\n",
+			expected: filepath.Join(tmpDir, "feed_state.json"),
+		},
+		{
+			name:     "file_pathも省略した場合はデフォルトのキャッシュディレクトリ",
+			yaml:     "cache:\n  enabled: true\n",
+			expected: filepath.Join(homeDir, ".ai-feed", "feed_state.json"),
+		},
+		{
+			name:     "指定したパスのチルダを展開する",
+			yaml:     "cache:\n  enabled: true\n  feed_state_file_path: ~/.ai-feed/state.json\n",
+			expected: filepath.Join(homeDir, ".ai-feed", "state.json"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filePath := filepath.Join(t.TempDir(), "config.yml")
+			assert.NoError(t, os.WriteFile(filePath, []byte(tt.yaml), 0644))
+
+			config, err := NewYamlConfigRepository(filePath).Load()
+			assert.NoError(t, err)
+			if assert.NotNil(t, config.Cache) {
+				assert.Equal(t, tt.expected, config.Cache.FeedStateFilePath)
+			}
+		})
+	}
+}
+
 func TestOutputConfig_UnmarshalYAML(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -1026,10 +1104,12 @@ func TestCacheConfig_ToEntity(t *testing.T) {
 				RetentionDays: 30,
 			},
 			expected: &entity.CacheConfig{
-				Enabled:       testutil.BoolPtr(true),
-				FilePath:      "/tmp/cache.jsonl",
-				MaxEntries:    1000,
-				RetentionDays: 30,
+				Enabled:           testutil.BoolPtr(true),
+				FilePath:          "/tmp/cache.jsonl",
+				MaxEntries:        1000,
+				RetentionDays:     30,
+				FeedStateFilePath: "/tmp/feed_state.json",
+				ArticleCacheDir:   "/tmp/articles",
 			},
 			expectedErr: "",
 		},
@@ -1042,10 +1122,12 @@ func TestCacheConfig_ToEntity(t *testing.T) {
 				RetentionDays: 0,
 			},
 			expected: &entity.CacheConfig{
-				Enabled:       testutil.BoolPtr(false),
-				FilePath:      filepath.Join(homeDir, ".ai-feed", "recommend_history.jsonl"),
-				MaxEntries:    1000,
-				RetentionDays: 30,
+				Enabled:           testutil.BoolPtr(false),
+				FilePath:          filepath.Join(homeDir, ".ai-feed", "recommend_history.jsonl"),
+				MaxEntries:        1000,
+				RetentionDays:     30,
+				FeedStateFilePath: filepath.Join(homeDir, ".ai-feed", "feed_state.json"),
+				ArticleCacheDir:   filepath.Join(homeDir, ".ai-feed", "articles"),
 			},
 			expectedErr: "",
 		},
@@ -1058,10 +1140,12 @@ func TestCacheConfig_ToEntity(t *testing.T) {
 				RetentionDays: 15,
 			},
 			expected: &entity.CacheConfig{
-				Enabled:       testutil.BoolPtr(true),
-				FilePath:      filepath.Join(homeDir, ".ai-feed", "custom-cache.jsonl"),
-				MaxEntries:    500,
-				RetentionDays: 15,
+				Enabled:           testutil.BoolPtr(true),
+				FilePath:          filepath.Join(homeDir, ".ai-feed", "custom-cache.jsonl"),
+				MaxEntries:        500,
+				RetentionDays:     15,
+				FeedStateFilePath: filepath.Join(homeDir, ".ai-feed", "feed_state.json"),
+				ArticleCacheDir:   filepath.Join(homeDir, ".ai-feed", "articles"),
 			},
 			expectedErr: "",
 		},
@@ -1074,10 +1158,46 @@ func TestCacheConfig_ToEntity(t *testing.T) {
 				RetentionDays: 60,
 			},
 			expected: &entity.CacheConfig{
-				Enabled:       testutil.BoolPtr(false),
-				FilePath:      filepath.Join(".", "cache", "data.jsonl"),
-				MaxEntries:    2000,
-				RetentionDays: 60,
+				Enabled:           testutil.BoolPtr(false),
+				FilePath:          filepath.Join(".", "cache", "data.jsonl"),
+				MaxEntries:        2000,
+				RetentionDays:     60,
+				FeedStateFilePath: filepath.Join(".", "cache", "feed_state.json"),
+				ArticleCacheDir:   filepath.Join(".", "cache", "articles"),
+			},
+			expectedErr: "",
+		},
+		{
+			name: "取得状態ファイルのパス指定",
+			config: CacheConfig{
+				Enabled:           testutil.BoolPtr(true),
+				FilePath:          "/tmp/cache.jsonl",
+				FeedStateFilePath: "~/.ai-feed/state.json",
+			},
+			expected: &entity.CacheConfig{
+				Enabled:           testutil.BoolPtr(true),
+				FilePath:          "/tmp/cache.jsonl",
+				MaxEntries:        1000,
+				RetentionDays:     30,
+				FeedStateFilePath: filepath.Join(homeDir, ".ai-feed", "state.json"),
+				ArticleCacheDir:   "/tmp/articles",
+			},
+			expectedErr: "",
+		},
+		{
+			name: "記事本文の保存先の指定",
+			config: CacheConfig{
+				Enabled:         testutil.BoolPtr(true),
+				FilePath:        "/tmp/cache.jsonl",
+				ArticleCacheDir: "~/.ai-feed/full_text",
+			},
+			expected: &entity.CacheConfig{
+				Enabled:           testutil.BoolPtr(true),
+				FilePath:          "/tmp/cache.jsonl",
+				MaxEntries:        1000,
+				RetentionDays:     30,
+				FeedStateFilePath: "/tmp/feed_state.json",
+				ArticleCacheDir:   filepath.Join(homeDir, ".ai-feed", "full_text"),
 			},
 			expectedErr: "",
 		},
@@ -1102,6 +1222,10 @@ func TestCacheConfig_ToEntity(t *testing.T) {
 				assert.Equal(t, expectedPath, actualPath)
 				assert.Equal(t, tt.expected.MaxEntries, result.MaxEntries)
 				assert.Equal(t, tt.expected.RetentionDays, result.RetentionDays)
+				expectedStatePath, _ := filepath.Abs(tt.expected.FeedStateFilePath)
+				assert.Equal(t, expectedStatePath, result.FeedStateFilePath)
+				expectedArticleCacheDir, _ := filepath.Abs(tt.expected.ArticleCacheDir)
+				assert.Equal(t, expectedArticleCacheDir, result.ArticleCacheDir)
 			}
 		})
 	}
@@ -1196,3 +1320,521 @@ cache:
 		})
 	}
 }
+
+func TestFetchConfig_ToEntity(t *testing.T) {
+	homeDir, err := os.UserHomeDir()
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		yamlStr     string
+		expected    *entity.FetchConfig
+		expectedErr string
+	}{
+		{
+			name: "全項目の変換",
+			yamlStr: `
+user_agent: ai-feed-bot/1.0
+timeout: 45s
+deadline: 3m
+concurrency: 8
+proxy_url: http://proxy.example.com:8080
+headers:
+  X-Custom: value
+ca_bundle_path: ~/certs/internal-ca.pem
+max_body_size: 1048576
+auto_discover: true
+`,
+			expected: &entity.FetchConfig{
+				UserAgent:    "ai-feed-bot/1.0",
+				Timeout:      45 * time.Second,
+				Deadline:     3 * time.Minute,
+				Concurrency:  8,
+				ProxyURL:     "http://proxy.example.com:8080",
+				Headers:      map[string]string{"X-Custom": "value"},
+				CABundlePath: filepath.Join(homeDir, "certs", "internal-ca.pem"),
+				MaxBodySize:  1048576,
+				AutoDiscover: testutil.BoolPtr(true),
+			},
+		},
+		{
+			name:     "省略時はゼロ値",
+			yamlStr:  `user_agent: ai-feed-bot/1.0`,
+			expected: &entity.FetchConfig{UserAgent: "ai-feed-bot/1.0"},
+		},
+		{
+			name:        "タイムアウトの形式が不正",
+			yamlStr:     `timeout: 30`,
+			expectedErr: "fetch.timeout の形式が不正です",
+		},
+		{
+			name:        "全体のタイムアウトの形式が不正",
+			yamlStr:     `deadline: 2`,
+			expectedErr: "fetch.deadline の形式が不正です",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var config FetchConfig
+			assert.NoError(t, yaml.Unmarshal([]byte(tt.yamlStr), &config))
+
+			result, err := config.ToEntity()
+
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				assert.Nil(t, result)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestProfile_ToEntity_FeedSelection(t *testing.T) {
+	tests := []struct {
+		name     string
+		yamlStr  string
+		expected *entity.FeedSelectionConfig
+	}{
+		{
+			name: "戦略の指定",
+			yamlStr: `
+feed_selection:
+  strategy: round_robin
+`,
+			expected: &entity.FeedSelectionConfig{Strategy: entity.FeedSelectionStrategyRoundRobin},
+		},
+		{
+			name: "大文字や空白を含む指定",
+			yamlStr: `
+feed_selection:
+  strategy: " Weighted "
+`,
+			expected: &entity.FeedSelectionConfig{Strategy: entity.FeedSelectionStrategyWeighted},
+		},
+		{
+			name:     "省略時はnil",
+			yamlStr:  `system_prompt: test`,
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var profile Profile
+			assert.NoError(t, yaml.Unmarshal([]byte(tt.yamlStr), &profile))
+
+			result, err := profile.ToEntity()
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result.FeedSelection)
+		})
+	}
+}
+
+func TestProfile_ToEntity_ContentBudget(t *testing.T) {
+	yamlStr := `
+system_prompt: test
+content_budget:
+  selector:
+    max_chars: 300
+  comment:
+    max_chars: 2000
+    max_tokens: 800
+`
+	var profile Profile
+	assert.NoError(t, yaml.Unmarshal([]byte(yamlStr), &profile))
+
+	result, err := profile.ToEntity()
+	assert.NoError(t, err)
+	assert.Equal(t, entity.ContentBudget{MaxChars: 300}, result.Prompt.SelectorContentBudget)
+	assert.Equal(t, entity.ContentBudget{MaxChars: 2000, MaxTokens: 800}, result.Prompt.CommentContentBudget)
+}
+
+func TestProfile_ToEntity_FullText(t *testing.T) {
+	yamlStr := `
+system_prompt: test
+full_text:
+  enabled: true
+  min_content_length: 300
+feeds:
+  - url: https://example.com/summary.xml
+    full_text: false
+`
+	var profile Profile
+	assert.NoError(t, yaml.Unmarshal([]byte(yamlStr), &profile))
+
+	result, err := profile.ToEntity()
+	assert.NoError(t, err)
+	assert.NotNil(t, result.FullText)
+	assert.True(t, *result.FullText.Enabled)
+	assert.Equal(t, 300, result.FullText.MinContentLength)
+	assert.Len(t, result.Feeds, 1)
+	assert.False(t, result.FullText.IsEnabledFor(result.Feeds[0]))
+}
+
+func TestProfile_ToEntity_CanonicalURL(t *testing.T) {
+	yamlStr := `
+system_prompt: test
+canonical_url:
+  strip_params: [ref, "share_*"]
+  follow_redirects: true
+  use_canonical_link: false
+  rewrite_amp_paths: true
+`
+	var profile Profile
+	assert.NoError(t, yaml.Unmarshal([]byte(yamlStr), &profile))
+
+	result, err := profile.ToEntity()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ref", "share_*"}, result.CanonicalURL.StripParams)
+	assert.True(t, result.CanonicalURL.IsFollowRedirectsEnabled())
+	assert.False(t, result.CanonicalURL.IsCanonicalLinkEnabled())
+	assert.True(t, result.CanonicalURL.IsRewriteAMPPathsEnabled())
+}
+
+func TestProfile_ToEntity_Dedup(t *testing.T) {
+	yamlStr := `
+system_prompt: test
+dedup:
+  enabled: false
+  title_similarity: 0.9
+  keep: earliest
+`
+	var profile Profile
+	assert.NoError(t, yaml.Unmarshal([]byte(yamlStr), &profile))
+
+	result, err := profile.ToEntity()
+	assert.NoError(t, err)
+	assert.False(t, result.Dedup.IsEnabled())
+	assert.Equal(t, 0.9, result.Dedup.TitleSimilarityOrDefault())
+	assert.Equal(t, entity.DedupKeepEarliest, result.Dedup.KeepOrDefault())
+}
+
+func TestProfile_ToEntity_TopicSuppression(t *testing.T) {
+	tests := []struct {
+		name        string
+		yaml        string
+		expected    *entity.TopicSuppressionConfig
+		expectError string
+	}{
+		{
+			name: "すべて指定",
+			yaml: `
+topic_suppression:
+  enabled: true
+  similarity: 0.85
+  window: 72h
+  action: demote
+`,
+			expected: &entity.TopicSuppressionConfig{
+				Enabled:    testutil.BoolPtr(true),
+				Similarity: 0.85,
+				Window:     72 * time.Hour,
+				Action:     entity.TopicSuppressionActionDemote,
+			},
+		},
+		{
+			name: "不正な期間",
+			yaml: `
+topic_suppression:
+  window: 1week
+`,
+			expectError: "topic_suppression.window の形式が不正です（例: 168h, 72h）: 1week",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var profile Profile
+			assert.NoError(t, yaml.Unmarshal([]byte(tt.yaml), &profile))
+
+			result, err := profile.ToEntity()
+			if tt.expectError != "" {
+				assert.EqualError(t, err, tt.expectError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result.TopicSuppression)
+		})
+	}
+}
+
+func TestProfile_ToEntity_AIRetry(t *testing.T) {
+	var profile Profile
+	assert.NoError(t, yaml.Unmarshal([]byte(`
+ai:
+  gemini:
+    type: gemini-2.5-flash
+    api_key: test-key
+    fallback_models:
+      - gemini-2.0-flash
+  retry:
+    max_attempts: 5
+    initial_interval: 500ms
+    max_interval: 1m
+`), &profile))
+
+	result, err := profile.ToEntity()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"gemini-2.0-flash"}, result.AI.Gemini.FallbackModels)
+	assert.Equal(t, &entity.AIRetryConfig{MaxAttempts: 5, InitialInterval: 500 * time.Millisecond, MaxInterval: time.Minute}, result.AI.Retry)
+
+	profile = Profile{}
+	assert.NoError(t, yaml.Unmarshal([]byte("ai:\n  retry:\n    max_interval: 1 minute\n"), &profile))
+	_, err = profile.ToEntity()
+	assert.EqualError(t, err, "ai.retry.max_interval の形式が不正です（例: 30s, 1m）: 1 minute")
+}
+
+func TestProfile_ToEntity_AIChain(t *testing.T) {
+	var profile Profile
+	assert.NoError(t, yaml.Unmarshal([]byte(`
+ai:
+  chain: [gemini, openai, mock]
+  mock:
+    comment: AIのコメントを生成できませんでした
+`), &profile))
+
+	result, err := profile.ToEntity()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"gemini", "openai", "mock"}, result.AI.Chain)
+	assert.Equal(t, "AIのコメントを生成できませんでした", result.AI.Mock.Comment)
+}
+
+func TestProfile_ToEntity_AIChainMissingAPIKey(t *testing.T) {
+	t.Setenv("AI_FEED_TEST_UNSET_GEMINI_KEY", "")
+	t.Setenv("AI_FEED_TEST_UNSET_OPENAI_KEY", "")
+
+	tests := []struct {
+		name    string
+		yaml    string
+		wantErr string
+	}{
+		{
+			name: "呼び出し順に含まれるプロバイダはAPIキーがなくても読み込める",
+			yaml: `
+ai:
+  chain: [gemini, openai, mock]
+  gemini:
+    type: gemini-2.5-flash
+    api_key_env: AI_FEED_TEST_UNSET_GEMINI_KEY
+  openai:
+    model: gpt-4o-mini
+    api_key_env: AI_FEED_TEST_UNSET_OPENAI_KEY
+`,
+		},
+		{
+			name: "呼び出し順に含まれないプロバイダはエラー",
+			yaml: `
+ai:
+  chain: [openai, mock]
+  gemini:
+    type: gemini-2.5-flash
+    api_key_env: AI_FEED_TEST_UNSET_GEMINI_KEY
+`,
+			wantErr: "環境変数 'AI_FEED_TEST_UNSET_GEMINI_KEY' が設定されていません。ai.gemini.api_key_env で指定された環境変数を設定してください。",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var profile Profile
+			assert.NoError(t, yaml.Unmarshal([]byte(tt.yaml), &profile))
+
+			result, err := profile.ToEntity()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, result.AI.Gemini.APIKey.IsEmpty())
+			assert.True(t, result.AI.OpenAI.APIKey.IsEmpty())
+			// APIキーのないプロバイダは飛ばし、mockを使える
+			assert.True(t, result.AI.Validate().IsValid)
+			assert.False(t, result.AI.IsChainProviderUsable("gemini"))
+		})
+	}
+}
+
+func TestProfile_ToEntity_Ollama(t *testing.T) {
+	var profile Profile
+	assert.NoError(t, yaml.Unmarshal([]byte(`
+ai:
+  ollama:
+    base_url: " http://gpu-server:11434 "
+    model: llama3.1
+    keep_alive: 10m
+`), &profile))
+
+	result, err := profile.ToEntity()
+	assert.NoError(t, err)
+	assert.Nil(t, result.AI.Gemini)
+	assert.Equal(t, &entity.OllamaConfig{BaseURL: "http://gpu-server:11434", Model: "llama3.1", KeepAlive: "10m"}, result.AI.Ollama)
+}
+
+func TestProfile_ToEntity_OpenAI(t *testing.T) {
+	t.Setenv("TEST_OPENAI_KEY", "env-key")
+
+	var profile Profile
+	assert.NoError(t, yaml.Unmarshal([]byte(`
+ai:
+  openai:
+    base_url: http://localhost:11434/v1
+    model: llama3.1
+    api_key_env: TEST_OPENAI_KEY
+    json_mode: false
+`), &profile))
+
+	result, err := profile.ToEntity()
+	assert.NoError(t, err)
+	assert.Nil(t, result.AI.Gemini)
+	if assert.NotNil(t, result.AI.OpenAI) {
+		assert.Equal(t, "http://localhost:11434/v1", result.AI.OpenAI.BaseURL)
+		assert.Equal(t, "llama3.1", result.AI.OpenAI.Model)
+		assert.Equal(t, "env-key", result.AI.OpenAI.APIKey.Value())
+		assert.False(t, result.AI.OpenAI.IsJSONModeEnabled())
+	}
+
+	profile = Profile{}
+	assert.NoError(t, yaml.Unmarshal([]byte("ai:\n  openai:\n    model: gpt-4o-mini\n    api_key_env: MISSING_OPENAI_KEY\n"), &profile))
+	_, err = profile.ToEntity()
+	assert.EqualError(t, err, "環境変数 'MISSING_OPENAI_KEY' が設定されていません。ai.openai.api_key_env で指定された環境変数を設定してください。")
+}
+
+func TestProfile_ToEntity_Seed(t *testing.T) {
+	var profile Profile
+	assert.NoError(t, yaml.Unmarshal([]byte("seed: 42"), &profile))
+	result, err := profile.ToEntity()
+	assert.NoError(t, err)
+	if assert.NotNil(t, result.Seed) {
+		assert.Equal(t, uint64(42), *result.Seed)
+	}
+
+	profile = Profile{}
+	assert.NoError(t, yaml.Unmarshal([]byte("system_prompt: test"), &profile))
+	result, err = profile.ToEntity()
+	assert.NoError(t, err)
+	assert.Nil(t, result.Seed)
+}
+
+func TestProfile_ToEntity_FeedHealth(t *testing.T) {
+	tests := []struct {
+		name        string
+		yamlStr     string
+		expected    *entity.FeedHealthConfig
+		expectError string
+	}{
+		{
+			name: "すべての項目を指定",
+			yamlStr: `
+feed_health:
+  enabled: false
+  failure_threshold: 5
+  cooldown: 12h
+`,
+			expected: &entity.FeedHealthConfig{
+				Enabled:          testutil.BoolPtr(false),
+				FailureThreshold: 5,
+				Cooldown:         12 * time.Hour,
+			},
+		},
+		{
+			name: "除外期間の形式が不正",
+			yamlStr: `
+feed_health:
+  cooldown: 1day
+`,
+			expectError: "feed_health.cooldown の形式が不正です（例: 12h, 30m）: 1day",
+		},
+		{
+			name:     "省略時はnil",
+			yamlStr:  `system_prompt: test`,
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var profile Profile
+			assert.NoError(t, yaml.Unmarshal([]byte(tt.yamlStr), &profile))
+
+			result, err := profile.ToEntity()
+
+			if tt.expectError != "" {
+				assert.EqualError(t, err, tt.expectError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result.FeedHealth)
+		})
+	}
+}
+
+func TestProfile_ToEntity_Filters(t *testing.T) {
+	tests := []struct {
+		name        string
+		yamlStr     string
+		expected    *entity.FilterConfig
+		expectError string
+	}{
+		{
+			name: "すべての項目を指定",
+			yamlStr: `
+filters:
+  include_keywords: [go, rust]
+  exclude_keywords: [sponsored]
+  include_patterns: ["Go \\d+"]
+  exclude_patterns: ["/jobs/"]
+  fields: [Title, " url "]
+  allow_categories: [tech]
+  deny_categories: [pr]
+  blocked_domains: [spam.example.com]
+  min_content_length: 200
+  max_age: 72h
+`,
+			expected: &entity.FilterConfig{
+				IncludeKeywords:  []string{"go", "rust"},
+				ExcludeKeywords:  []string{"sponsored"},
+				IncludePatterns:  []string{`Go \d+`},
+				ExcludePatterns:  []string{"/jobs/"},
+				Fields:           []string{"title", "url"},
+				AllowCategories:  []string{"tech"},
+				DenyCategories:   []string{"pr"},
+				BlockedDomains:   []string{"spam.example.com"},
+				MinContentLength: 200,
+				MaxAge:           72 * time.Hour,
+			},
+		},
+		{
+			name: "最大経過時間の形式が不正",
+			yamlStr: `
+filters:
+  max_age: 3days
+`,
+			expectError: "filters.max_age の形式が不正です（例: 72h, 30m）: 3days",
+		},
+		{
+			name:     "省略時はnil",
+			yamlStr:  `system_prompt: test`,
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var profile Profile
+			assert.NoError(t, yaml.Unmarshal([]byte(tt.yamlStr), &profile))
+
+			result, err := profile.ToEntity()
+
+			if tt.expectError != "" {
+				assert.EqualError(t, err, tt.expectError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result.Filters)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package content
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/canpok1/ai-feed/internal/domain"
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+)
+
+// truncationMarker は本文を切り詰めたときに末尾へ付ける記号
+const truncationMarker = "…"
+
+// Prepare は記事本文をプレーンテキストに変換し、上限に収まるように切り詰める
+func Prepare(raw string, budget entity.ContentBudget) string {
+	return Truncate(domain.NormalizeContent(raw), budget)
+}
+
+// Truncate はテキストを上限の文字数・トークン数に収まるように切り詰める
+// 切り詰めた場合は末尾にtruncationMarkerを付ける
+func Truncate(text string, budget entity.ContentBudget) string {
+	if budget.MaxChars <= 0 && budget.MaxTokens <= 0 {
+		return text
+	}
+
+	chars := 0
+	tokens := 0.0
+	for i, r := range text {
+		chars++
+		tokens += runeTokenWeight(r)
+		if (budget.MaxChars > 0 && chars > budget.MaxChars) ||
+			(budget.MaxTokens > 0 && tokens > float64(budget.MaxTokens)) {
+			return strings.TrimRightFunc(text[:i], unicode.IsSpace) + truncationMarker
+		}
+	}
+	return text
+}
+
+// runeTokenWeight は1文字あたりのトークン数の目安を返す
+// 英数字などのASCII文字は4文字で1トークン、それ以外（日本語など）は1文字で1トークンとして見積もる
+func runeTokenWeight(r rune) float64 {
+	if r < utf8.RuneSelf {
+		return 0.25
+	}
+	return 1
+}
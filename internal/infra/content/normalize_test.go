@@ -0,0 +1,72 @@
+package content
+
+import (
+	"testing"
+
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		budget   entity.ContentBudget
+		expected string
+	}{
+		{
+			name:     "上限なしの場合は切り詰めない",
+			text:     "abcdefghij",
+			budget:   entity.ContentBudget{},
+			expected: "abcdefghij",
+		},
+		{
+			name:     "上限以内の場合は切り詰めない",
+			text:     "abcdefghij",
+			budget:   entity.ContentBudget{MaxChars: 10},
+			expected: "abcdefghij",
+		},
+		{
+			name:     "文字数で切り詰める",
+			text:     "あいうえおかきくけこ",
+			budget:   entity.ContentBudget{MaxChars: 5},
+			expected: "あいうえお…",
+		},
+		{
+			name:     "切り詰めた位置の末尾の空白を取り除く",
+			text:     "hello world",
+			budget:   entity.ContentBudget{MaxChars: 6},
+			expected: "hello…",
+		},
+		{
+			name:     "ASCII文字は4文字で1トークンとして切り詰める",
+			text:     "abcdefghijklmnop",
+			budget:   entity.ContentBudget{MaxTokens: 2},
+			expected: "abcdefgh…",
+		},
+		{
+			name:     "非ASCII文字は1文字で1トークンとして切り詰める",
+			text:     "あいうえおかきくけこ",
+			budget:   entity.ContentBudget{MaxTokens: 3},
+			expected: "あいう…",
+		},
+		{
+			name:     "先に上限に達した方で切り詰める",
+			text:     "あいうえおかきくけこ",
+			budget:   entity.ContentBudget{MaxChars: 8, MaxTokens: 4},
+			expected: "あいうえ…",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Truncate(tt.text, tt.budget))
+		})
+	}
+}
+
+func TestPrepare(t *testing.T) {
+	raw := "<p>Hello <a href=\"https://example.com\">world</a></p><script>track()</script><p>Second paragraph</p>"
+
+	assert.Equal(t, "Hello world\nSecond…", Prepare(raw, entity.ContentBudget{MaxChars: 18}))
+}
@@ -0,0 +1,135 @@
+package infra
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+)
+
+// FeedSourceFile はYAML形式のフィード定義ファイル
+type FeedSourceFile struct {
+	Feeds []FeedConfig `yaml:"feeds"`
+}
+
+// FeedConfig は1件のフィード定義
+type FeedConfig struct {
+	URL     string            `yaml:"url"`
+	Name    string            `yaml:"name,omitempty"`
+	Tags    []string          `yaml:"tags,omitempty"`
+	Weight  float64           `yaml:"weight,omitempty"`
+	Enabled *bool             `yaml:"enabled,omitempty"`
+	Auth    *FeedAuthConfig   `yaml:"auth,omitempty"`
+	Headers map[string]string `yaml:"headers,omitempty"`
+	// FullText は本文が短い記事で記事ページから本文を取得するかどうか（省略時はプロファイルのfull_text.enabledに従う）
+	FullText *bool `yaml:"full_text,omitempty"`
+}
+
+// FeedAuthConfig はフィード取得時の認証設定
+type FeedAuthConfig struct {
+	Type        string `yaml:"type"` // basic, bearer, header
+	Username    string `yaml:"username,omitempty"`
+	Password    string `yaml:"password,omitempty"`
+	PasswordEnv string `yaml:"password_env,omitempty"`
+	Token       string `yaml:"token,omitempty"`
+	TokenEnv    string `yaml:"token_env,omitempty"`
+	HeaderName  string `yaml:"header_name,omitempty"`
+}
+
+// ToEntity converts infra.FeedConfig to entity.Feed
+// configPathはエラーメッセージに表示する設定項目のパス（例: feeds[0]）
+func (c *FeedConfig) ToEntity(configPath string) (*entity.Feed, error) {
+	feed := &entity.Feed{
+		URL:      strings.TrimSpace(c.URL),
+		Name:     c.Name,
+		Tags:     c.Tags,
+		Weight:   c.Weight,
+		Enabled:  resolveEnabledPtr(c.Enabled),
+		Headers:  c.Headers,
+		FullText: c.FullText,
+	}
+
+	if c.Auth != nil {
+		feed.Auth = &entity.FeedAuth{
+			Type:       strings.ToLower(c.Auth.Type),
+			Username:   c.Auth.Username,
+			HeaderName: c.Auth.HeaderName,
+		}
+
+		// 無効化されている場合は、認証情報の解決をスキップ（環境変数が未設定でも読み込めるように）
+		if *feed.Enabled {
+			password, err := resolveSecretString(c.Auth.Password, c.Auth.PasswordEnv, configPath+".auth.password_env")
+			if err != nil {
+				return nil, err
+			}
+			token, err := resolveSecretString(c.Auth.Token, c.Auth.TokenEnv, configPath+".auth.token_env")
+			if err != nil {
+				return nil, err
+			}
+			feed.Auth.Password = password
+			feed.Auth.Token = token
+		}
+	}
+
+	return feed, nil
+}
+
+// isYAMLFile はファイルの拡張子からYAML形式かどうかを判定する
+func isYAMLFile(filePath string) bool {
+	ext := strings.ToLower(filepath.Ext(filePath))
+	return ext == ".yml" || ext == ".yaml"
+}
+
+// ReadFeedsFromFile はフィード定義ファイルを読み込む
+// 拡張子が .yml/.yaml の場合はYAML形式のフィード定義、OPMLの場合は購読リスト、それ以外は1行1URLのテキスト形式として扱う
+func ReadFeedsFromFile(filePath string) ([]entity.Feed, error) {
+	if !isYAMLFile(filePath) {
+		data, err := os.ReadFile(filePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open file %s: %w", filePath, err)
+		}
+		if isOPMLFile(filePath, data) {
+			feeds, err := ParseOPML(bytes.NewReader(data))
+			if err != nil {
+				return nil, fmt.Errorf("failed to read OPML %s: %w", filePath, err)
+			}
+			return feeds, nil
+		}
+
+		urls, err := ReadURLsFromFile(filePath)
+		if err != nil {
+			return nil, err
+		}
+		return entity.NewFeedsFromURLs(urls), nil
+	}
+
+	source, err := LoadYAML[FeedSourceFile](filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load feed source %s: %w", filePath, err)
+	}
+
+	feeds, err := feedConfigsToEntities(source.Feeds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read feed source %s: %w", filePath, err)
+	}
+	return feeds, nil
+}
+
+// feedConfigsToEntities はフィード定義の一覧をentity.Feedの一覧に変換する
+func feedConfigsToEntities(configs []FeedConfig) ([]entity.Feed, error) {
+	if len(configs) == 0 {
+		return nil, nil
+	}
+	feeds := make([]entity.Feed, 0, len(configs))
+	for i, feedConfig := range configs {
+		feed, err := feedConfig.ToEntity(fmt.Sprintf("feeds[%d]", i))
+		if err != nil {
+			return nil, err
+		}
+		feeds = append(feeds, *feed)
+	}
+	return feeds, nil
+}
@@ -0,0 +1,131 @@
+package infra
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"github.com/canpok1/ai-feed/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadFeedsFromFile(t *testing.T) {
+	tests := []struct {
+		name        string
+		fileName    string
+		content     string
+		expected    []entity.Feed
+		expectedErr string
+	}{
+		{
+			name:     "テキスト形式は1行1URLとして読み込む",
+			fileName: "feeds.txt",
+			content:  "https://example.com/a.xml\n\nhttps://example.com/b.xml\n",
+			expected: []entity.Feed{
+				{URL: "https://example.com/a.xml"},
+				{URL: "https://example.com/b.xml"},
+			},
+		},
+		{
+			name:     "YAML形式のフィードカタログ",
+			fileName: "feeds.yaml",
+			content: `feeds:
+  - url: https://example.com/a.xml
+    name: Example A
+    tags: [tech, go]
+    weight: 2.5
+  - url: https://example.com/b.xml
+    enabled: false
+`,
+			expected: []entity.Feed{
+				{
+					URL:     "https://example.com/a.xml",
+					Name:    "Example A",
+					Tags:    []string{"tech", "go"},
+					Weight:  2.5,
+					Enabled: testutil.BoolPtr(true),
+				},
+				{
+					URL:     "https://example.com/b.xml",
+					Enabled: testutil.BoolPtr(false),
+				},
+			},
+		},
+		{
+			name:        "YAMLの形式が不正",
+			fileName:    "feeds.yml",
+			content:     "feeds: [",
+			expectedErr: "failed to load feed source",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filePath := filepath.Join(t.TempDir(), tt.fileName)
+			require.NoError(t, os.WriteFile(filePath, []byte(tt.content), 0644))
+
+			feeds, err := ReadFeedsFromFile(filePath)
+
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, feeds)
+		})
+	}
+}
+
+func TestProfile_ToEntity_Feeds(t *testing.T) {
+	t.Setenv("TEST_FEED_PASSWORD", "secret")
+
+	profile := &Profile{
+		Feeds: []FeedConfig{
+			{URL: "https://example.com/a.xml", Name: "A", Tags: []string{"news"}},
+			{
+				URL:  "https://example.com/private.xml",
+				Auth: &FeedAuthConfig{Type: "Basic", Username: "user", PasswordEnv: "TEST_FEED_PASSWORD"},
+			},
+		},
+	}
+
+	result, err := profile.ToEntity()
+
+	require.NoError(t, err)
+	require.Len(t, result.Feeds, 2)
+	assert.Equal(t, "A", result.Feeds[0].Name)
+	assert.Equal(t, []string{"news"}, result.Feeds[0].Tags)
+	assert.True(t, result.Feeds[0].IsEnabled())
+	require.NotNil(t, result.Feeds[1].Auth)
+	assert.Equal(t, entity.FeedAuthTypeBasic, result.Feeds[1].Auth.Type)
+	assert.Equal(t, "secret", result.Feeds[1].Auth.Password.Value())
+}
+
+func TestProfile_ToEntity_DisabledFeedSecret(t *testing.T) {
+	t.Setenv("TEST_UNSET_FEED_TOKEN", "")
+
+	profile := &Profile{
+		Feeds: []FeedConfig{
+			{
+				URL:     "https://example.com/private.xml",
+				Enabled: testutil.BoolPtr(false),
+				Auth:    &FeedAuthConfig{Type: "bearer", TokenEnv: "TEST_UNSET_FEED_TOKEN"},
+			},
+		},
+	}
+
+	// 無効なフィードは環境変数が未設定でも読み込める
+	result, err := profile.ToEntity()
+	require.NoError(t, err)
+	require.Len(t, result.Feeds, 1)
+	assert.False(t, result.Feeds[0].IsEnabled())
+	assert.True(t, result.Feeds[0].Auth.Token.IsEmpty())
+	assert.True(t, result.Feeds[0].Validate().IsValid)
+
+	// 有効なフィードはエラー
+	profile.Feeds[0].Enabled = nil
+	_, err = profile.ToEntity()
+	assert.ErrorContains(t, err, "TEST_UNSET_FEED_TOKEN")
+}
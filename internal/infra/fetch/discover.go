@@ -0,0 +1,252 @@
+package fetch
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/canpok1/ai-feed/internal/domain"
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"github.com/mmcdole/gofeed"
+	"golang.org/x/net/html"
+)
+
+// feedLinkTypes は<link rel="alternate">のtype属性とフィードの種類の対応
+var feedLinkTypes = map[string]string{
+	"application/rss+xml":   "rss",
+	"application/atom+xml":  "atom",
+	"application/feed+json": "json",
+}
+
+// commonFeedPaths はページにフィードへのリンクがない場合に試すパス
+var commonFeedPaths = []string{
+	"feed",
+	"feed.xml",
+	"rss",
+	"rss.xml",
+	"atom.xml",
+	"index.xml",
+	"feed.json",
+}
+
+// NewFeedDiscoverer はフィード取得設定からHTTPクライアントを構築してFeedDiscovererを作成する
+func NewFeedDiscoverer(config *entity.FetchConfig) (domain.FeedDiscoverer, error) {
+	client, err := NewFetchClient(config, nil)
+	if err != nil {
+		return nil, err
+	}
+	return client.(*FetchClient), nil
+}
+
+// Discover はページのURLからフィードを探す
+// ページ自体がフィードの場合はそのURLを、HTMLの場合は<link rel="alternate">で示されたフィードを返す
+// リンクが見つからない場合は、よく使われるパス（/feed, /rss.xml など）を順に試す
+func (f *FetchClient) Discover(ctx context.Context, pageURL string) ([]domain.DiscoveredFeed, error) {
+	return f.discover(ctx, entity.Feed{URL: pageURL})
+}
+
+// discover はフィードに設定されたURLのページからフィードを探す
+// フィードの認証情報とヘッダーは、設定されたURLと同じオリジンへのリクエストにのみ送信する
+func (f *FetchClient) discover(ctx context.Context, feed entity.Feed) ([]domain.DiscoveredFeed, error) {
+	pageURL := feed.URL
+	body, baseURL, err := f.getPage(ctx, scopeCredentials(feed, pageURL))
+	if err != nil {
+		return nil, err
+	}
+
+	if feed, ok := parseDiscoveredFeed(pageURL, body); ok {
+		return []domain.DiscoveredFeed{feed}, nil
+	}
+
+	feeds := findFeedLinks(body, baseURL)
+	if len(feeds) == 0 {
+		feeds = f.probeCommonFeedPaths(ctx, feed, baseURL)
+	}
+	if len(feeds) == 0 {
+		return nil, fmt.Errorf("%w: %s", domain.ErrFeedNotDiscovered, pageURL)
+	}
+	return feeds, nil
+}
+
+// getPage はページ（feed.URL）を取得して本文とリダイレクト後のURLを返す
+// feedの認証情報とヘッダーはscopeCredentialsで送信先に合わせて絞り込んでおく
+func (f *FetchClient) getPage(ctx context.Context, feed entity.Feed) ([]byte, *url.URL, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, feed.URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req = f.applyHeaders(req, feed)
+
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, nil, gofeed.HTTPError{
+			StatusCode: resp.StatusCode,
+			Status:     resp.Status,
+		}
+	}
+
+	var body io.Reader = resp.Body
+	if f.maxBodySize > 0 {
+		body = &limitedReader{r: resp.Body, left: f.maxBodySize}
+	}
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, nil, err
+	}
+	return data, resp.Request.URL, nil
+}
+
+// probeCommonFeedPaths はよく使われるフィードのパスを順に試し、最初に見つかったフィードを返す
+// ブログがサブディレクトリにある場合に備えて、ページのパス配下とサイトのルートの両方を試す
+func (f *FetchClient) probeCommonFeedPaths(ctx context.Context, feed entity.Feed, baseURL *url.URL) []domain.DiscoveredFeed {
+	var bases []*url.URL
+	if dir := baseURL.JoinPath("/"); dir.Path != "/" {
+		bases = append(bases, dir)
+	}
+	bases = append(bases, &url.URL{Scheme: baseURL.Scheme, Host: baseURL.Host, Path: "/"})
+
+	for _, base := range bases {
+		for _, path := range commonFeedPaths {
+			candidate := base.JoinPath(path).String()
+			body, _, err := f.getPage(ctx, scopeCredentials(feed, candidate))
+			if err != nil {
+				slog.Debug("Feed candidate not available", "url", candidate, "error", err)
+				continue
+			}
+			if feed, ok := parseDiscoveredFeed(candidate, body); ok {
+				return []domain.DiscoveredFeed{feed}
+			}
+		}
+	}
+	return nil
+}
+
+// scopeCredentials はURLをtargetURLに置き換えたフィードを返す
+// 認証情報とフィード固有のヘッダーは、targetURLがフィードに設定されたURLと同じオリジン（スキーム、ホスト、ポート）の場合のみ引き継ぐ
+func scopeCredentials(feed entity.Feed, targetURL string) entity.Feed {
+	scoped := feed
+	scoped.URL = targetURL
+	if !sameOrigin(feed.URL, targetURL) {
+		scoped.Auth = nil
+		scoped.Headers = nil
+	}
+	return scoped
+}
+
+// sameOrigin は2つのURLのスキームとホスト（ポートを含む）が同じかどうかを返す
+func sameOrigin(a, b string) bool {
+	ua, err := url.Parse(a)
+	if err != nil {
+		return false
+	}
+	ub, err := url.Parse(b)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(ua.Scheme, ub.Scheme) && strings.EqualFold(ua.Host, ub.Host)
+}
+
+// parseDiscoveredFeed は本文がフィードとして解析できる場合にフィードの情報を返す
+func parseDiscoveredFeed(feedURL string, body []byte) (domain.DiscoveredFeed, bool) {
+	feedType := gofeed.DetectFeedType(bytes.NewReader(body))
+	if feedType == gofeed.FeedTypeUnknown {
+		return domain.DiscoveredFeed{}, false
+	}
+	parsed, err := gofeed.NewParser().Parse(bytes.NewReader(body))
+	if err != nil {
+		return domain.DiscoveredFeed{}, false
+	}
+
+	var typeName string
+	switch feedType {
+	case gofeed.FeedTypeAtom:
+		typeName = "atom"
+	case gofeed.FeedTypeJSON:
+		typeName = "json"
+	default:
+		typeName = "rss"
+	}
+	return domain.DiscoveredFeed{URL: feedURL, Title: strings.TrimSpace(parsed.Title), Type: typeName}, true
+}
+
+// findFeedLinks はHTMLの<link rel="alternate">からフィードのURLを集める
+// 相対URLは<base href>またはページのURLを基準に解決する
+func findFeedLinks(body []byte, pageURL *url.URL) []domain.DiscoveredFeed {
+	doc, err := html.Parse(bytes.NewReader(body))
+	if err != nil {
+		return nil
+	}
+
+	baseURL := pageURL
+	var feeds []domain.DiscoveredFeed
+	seen := make(map[string]bool)
+	for node := range doc.Descendants() {
+		if node.Type != html.ElementNode {
+			continue
+		}
+		switch node.Data {
+		case "base":
+			if href := htmlAttr(node, "href"); href != "" {
+				if resolved, err := pageURL.Parse(href); err == nil {
+					baseURL = resolved
+				}
+			}
+		case "link":
+			if !hasRelAlternate(htmlAttr(node, "rel")) {
+				continue
+			}
+			feedType, ok := feedLinkTypes[strings.ToLower(strings.TrimSpace(htmlAttr(node, "type")))]
+			if !ok {
+				continue
+			}
+			href := strings.TrimSpace(htmlAttr(node, "href"))
+			if href == "" {
+				continue
+			}
+			resolved, err := baseURL.Parse(href)
+			if err != nil || seen[resolved.String()] {
+				continue
+			}
+			seen[resolved.String()] = true
+			feeds = append(feeds, domain.DiscoveredFeed{
+				URL:   resolved.String(),
+				Title: strings.TrimSpace(htmlAttr(node, "title")),
+				Type:  feedType,
+			})
+		}
+	}
+	return feeds
+}
+
+// hasRelAlternate はrel属性にalternateが含まれているかどうかを返す
+func hasRelAlternate(rel string) bool {
+	for _, value := range strings.Fields(rel) {
+		if strings.EqualFold(value, "alternate") {
+			return true
+		}
+	}
+	return false
+}
+
+// htmlAttr はHTML要素の属性値を返す（存在しない場合は空文字）
+func htmlAttr(node *html.Node, name string) string {
+	for _, attr := range node.Attr {
+		if strings.EqualFold(attr.Key, name) {
+			return attr.Val
+		}
+	}
+	return ""
+}
+
+// FetchClientがFeedDiscovererインターフェースを実装していることを確認する
+var _ domain.FeedDiscoverer = (*FetchClient)(nil)
@@ -0,0 +1,207 @@
+package fetch
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/canpok1/ai-feed/internal/domain"
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"github.com/canpok1/ai-feed/internal/infra/cache"
+	"github.com/canpok1/ai-feed/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAtom = `<?xml version="1.0" encoding="UTF-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+<title>Test Atom</title>
+<entry><title>Entry 1</title><link href="https://example.com/1"/><updated>2024-01-01T00:00:00Z</updated></entry>
+</feed>`
+
+func TestFetchClient_Discover(t *testing.T) {
+	tests := []struct {
+		name      string
+		routes    map[string]string
+		pagePath  string
+		expected  func(serverURL string) []domain.DiscoveredFeed
+		expectErr error
+	}{
+		{
+			name: "linkタグからフィードを見つける",
+			routes: map[string]string{
+				"/blog/": `<html><head>
+<link rel="alternate" type="application/rss+xml" title="RSS" href="/blog/rss.xml">
+<link rel="alternate" type="application/atom+xml" title="Atom" href="atom.xml">
+<link rel="alternate" type="application/json+oembed" href="/oembed">
+<link rel="stylesheet" type="text/css" href="/style.css">
+</head><body></body></html>`,
+			},
+			pagePath: "/blog/",
+			expected: func(serverURL string) []domain.DiscoveredFeed {
+				return []domain.DiscoveredFeed{
+					{URL: serverURL + "/blog/rss.xml", Title: "RSS", Type: "rss"},
+					{URL: serverURL + "/blog/atom.xml", Title: "Atom", Type: "atom"},
+				}
+			},
+		},
+		{
+			name: "base要素を基準に相対URLを解決する",
+			routes: map[string]string{
+				"/": `<html><head><base href="/site/">
+<link rel="Alternate" type="application/feed+json" href="feed.json">
+</head></html>`,
+			},
+			pagePath: "/",
+			expected: func(serverURL string) []domain.DiscoveredFeed {
+				return []domain.DiscoveredFeed{{URL: serverURL + "/site/feed.json", Type: "json"}}
+			},
+		},
+		{
+			name:     "ページ自体がフィードの場合はそのURLを返す",
+			routes:   map[string]string{"/atom.xml": testAtom},
+			pagePath: "/atom.xml",
+			expected: func(serverURL string) []domain.DiscoveredFeed {
+				return []domain.DiscoveredFeed{{URL: serverURL + "/atom.xml", Title: "Test Atom", Type: "atom"}}
+			},
+		},
+		{
+			name: "リンクがない場合はよく使われるパスを試す",
+			routes: map[string]string{
+				"/":        `<html><head><title>No links</title></head></html>`,
+				"/rss.xml": testRSS,
+			},
+			pagePath: "/",
+			expected: func(serverURL string) []domain.DiscoveredFeed {
+				return []domain.DiscoveredFeed{{URL: serverURL + "/rss.xml", Title: "Test Feed", Type: "rss"}}
+			},
+		},
+		{
+			name:      "フィードが見つからない",
+			routes:    map[string]string{"/": `<html><head><title>No links</title></head></html>`},
+			pagePath:  "/",
+			expectErr: domain.ErrFeedNotDiscovered,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, ok := tt.routes[r.URL.Path]
+				if !ok {
+					http.NotFound(w, r)
+					return
+				}
+				_, _ = w.Write([]byte(body))
+			}))
+			defer server.Close()
+
+			discoverer, err := NewFeedDiscoverer(nil)
+			require.NoError(t, err)
+
+			feeds, err := discoverer.Discover(context.Background(), server.URL+tt.pagePath)
+
+			if tt.expectErr != nil {
+				assert.ErrorIs(t, err, tt.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected(server.URL), feeds)
+		})
+	}
+}
+
+func TestFetchClient_Fetch_AutoDiscover(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/":
+			_, _ = w.Write([]byte(`<html><head><link rel="alternate" type="application/rss+xml" href="/feed.xml"></head></html>`))
+		case "/feed.xml":
+			_, _ = w.Write([]byte(testRSS))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	t.Run("自動検出が有効な場合はページから見つけたフィードを取得する", func(t *testing.T) {
+		client, err := NewFetchClient(&entity.FetchConfig{AutoDiscover: testutil.BoolPtr(true)}, cache.NewNopFeedStateStore())
+		require.NoError(t, err)
+
+		articles, err := client.Fetch(context.Background(), entity.Feed{URL: server.URL + "/"})
+
+		require.NoError(t, err)
+		require.Len(t, articles, 1)
+		assert.Equal(t, "Article 1", articles[0].Title)
+	})
+
+	t.Run("自動検出が無効な場合はfeeds discoverを案内する", func(t *testing.T) {
+		client, err := NewFetchClient(nil, cache.NewNopFeedStateStore())
+		require.NoError(t, err)
+
+		_, err = client.Fetch(context.Background(), entity.Feed{URL: server.URL + "/"})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "ai-feed feeds discover "+server.URL+"/")
+	})
+}
+
+func TestFetchClient_Fetch_AutoDiscoverCredentials(t *testing.T) {
+	feedAuth := &entity.FeedAuth{Type: entity.FeedAuthTypeBearer, Token: entity.NewSecretString("secret")}
+	feedHeaders := map[string]string{"X-Feed-Token": "secret"}
+
+	// 別のホストのフィード（認証情報を受け取ってはいけない）
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"))
+		assert.Empty(t, r.Header.Get("X-Feed-Token"))
+		_, _ = w.Write([]byte(testRSS))
+	}))
+	defer other.Close()
+
+	// 認証が必要なページと同じホストのフィード
+	page := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" || r.Header.Get("X-Feed-Token") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/same":
+			_, _ = w.Write([]byte(`<html><head><link rel="alternate" type="application/rss+xml" href="/feed.xml"></head></html>`))
+		case "/other":
+			_, _ = w.Write([]byte(`<html><head><link rel="alternate" type="application/rss+xml" href="` + other.URL + `/feed.xml"></head></html>`))
+		case "/feed.xml":
+			_, _ = w.Write([]byte(testRSS))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer page.Close()
+
+	tests := []struct {
+		name string
+		path string
+	}{
+		{name: "同じオリジンのページとフィードには認証情報を送る", path: "/same"},
+		{name: "別のオリジンのフィードには認証情報を送らない", path: "/other"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := NewFetchClient(&entity.FetchConfig{AutoDiscover: testutil.BoolPtr(true)}, cache.NewNopFeedStateStore())
+			require.NoError(t, err)
+
+			articles, err := client.Fetch(context.Background(), entity.Feed{URL: page.URL + tt.path, Auth: feedAuth, Headers: feedHeaders})
+
+			require.NoError(t, err)
+			assert.Len(t, articles, 1)
+		})
+	}
+}
+
+func TestSameOrigin(t *testing.T) {
+	assert.True(t, sameOrigin("https://example.com/page", "https://EXAMPLE.com/feed.xml"))
+	assert.False(t, sameOrigin("https://example.com/page", "http://example.com/feed.xml"))
+	assert.False(t, sameOrigin("https://example.com/page", "https://example.com:8443/feed.xml"))
+	assert.False(t, sameOrigin("https://example.com/page", "https://feeds.example.com/feed.xml"))
+}
@@ -0,0 +1,155 @@
+package fetch
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/canpok1/ai-feed/internal/domain"
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"golang.org/x/net/html"
+)
+
+// errNoReadableContent は記事ページから本文を見つけられなかった場合のエラー
+var errNoReadableContent = errors.New("no readable content found")
+
+// nonContentTags は本文の候補から除外する要素
+var nonContentTags = map[string]bool{
+	"script":   true,
+	"style":    true,
+	"noscript": true,
+	"template": true,
+	"iframe":   true,
+	"svg":      true,
+	"nav":      true,
+	"header":   true,
+	"footer":   true,
+	"aside":    true,
+	"form":     true,
+	"button":   true,
+}
+
+// minParagraphLength はスコア計算の対象にする段落の最小文字数
+const minParagraphLength = 25
+
+// NewArticleExtractor はフィード取得設定からHTTPクライアントを構築してArticleExtractorを作成する
+func NewArticleExtractor(config *entity.FetchConfig) (domain.ArticleExtractor, error) {
+	client, err := NewFetchClient(config, nil)
+	if err != nil {
+		return nil, err
+	}
+	return client.(*FetchClient), nil
+}
+
+// Extract は記事ページを取得し、本文をプレーンテキストで返す
+// <article>や<main>があればその中身を、なければ段落の多い要素を本文とみなす（readability方式の簡易版）
+func (f *FetchClient) Extract(ctx context.Context, articleURL string) (string, error) {
+	body, _, err := f.getPage(ctx, entity.Feed{URL: articleURL})
+	if err != nil {
+		return "", err
+	}
+
+	doc, err := html.Parse(bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("failed to parse article page: %w", err)
+	}
+
+	mainContent := findMainContent(doc)
+	if mainContent == nil {
+		return "", fmt.Errorf("%w: %s", errNoReadableContent, articleURL)
+	}
+
+	var buf bytes.Buffer
+	if err := html.Render(&buf, mainContent); err != nil {
+		return "", fmt.Errorf("failed to render article content: %w", err)
+	}
+	text := domain.NormalizeContent(buf.String())
+	if text == "" {
+		return "", fmt.Errorf("%w: %s", errNoReadableContent, articleURL)
+	}
+	return text, nil
+}
+
+// findMainContent は本文を含む要素を探す
+func findMainContent(doc *html.Node) *html.Node {
+	removeNonContent(doc)
+
+	// 本文を示す要素があれば、その中で最もテキストの多いものを使う
+	var best *html.Node
+	bestLength := 0
+	for node := range doc.Descendants() {
+		if node.Type != html.ElementNode || !isMainElement(node) {
+			continue
+		}
+		if length := textLength(node); length > bestLength {
+			best, bestLength = node, length
+		}
+	}
+	if best != nil {
+		return best
+	}
+
+	// なければ段落の長さを親要素（と祖父要素の半分）に加点し、最もスコアの高い要素を使う
+	scores := make(map[*html.Node]float64)
+	for node := range doc.Descendants() {
+		if node.Type != html.ElementNode || node.Data != "p" {
+			continue
+		}
+		length := textLength(node)
+		if length < minParagraphLength {
+			continue
+		}
+		score := 1 + float64(length)/100
+		if parent := node.Parent; parent != nil {
+			scores[parent] += score
+			if grandparent := parent.Parent; grandparent != nil {
+				scores[grandparent] += score / 2
+			}
+		}
+	}
+	var bestScore float64
+	for node, score := range scores {
+		if score > bestScore {
+			best, bestScore = node, score
+		}
+	}
+	return best
+}
+
+// isMainElement は本文を示す要素（<article>, <main>, role="main"）かどうかを返す
+func isMainElement(node *html.Node) bool {
+	if node.Data == "article" || node.Data == "main" {
+		return true
+	}
+	return htmlAttr(node, "role") == "main"
+}
+
+// removeNonContent はナビゲーションやスクリプトなど本文ではない要素を取り除く
+func removeNonContent(node *html.Node) {
+	for child := node.FirstChild; child != nil; {
+		next := child.NextSibling
+		if child.Type == html.CommentNode || (child.Type == html.ElementNode && nonContentTags[child.Data]) {
+			node.RemoveChild(child)
+		} else {
+			removeNonContent(child)
+		}
+		child = next
+	}
+}
+
+// textLength は要素に含まれるテキストの文字数を返す
+func textLength(node *html.Node) int {
+	length := 0
+	for descendant := range node.Descendants() {
+		if descendant.Type == html.TextNode {
+			length += utf8.RuneCountInString(strings.TrimSpace(descendant.Data))
+		}
+	}
+	return length
+}
+
+// FetchClientがArticleExtractorインターフェースを実装していることを確認する
+var _ domain.ArticleExtractor = (*FetchClient)(nil)
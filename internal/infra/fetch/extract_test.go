@@ -0,0 +1,77 @@
+package fetch
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchClient_Extract(t *testing.T) {
+	tests := []struct {
+		name        string
+		page        string
+		status      int
+		expected    string
+		expectError bool
+	}{
+		{
+			name: "article要素の本文を抽出する",
+			page: `<html><head><title>t</title><script>var x = 1;</script></head><body>
+<header><nav><a href="/">Home</a></nav></header>
+<article><h1>Go 1.25 released</h1><p>The Go team is happy to announce Go 1.25.</p>
+<aside>Related posts</aside><p>It includes &quot;many&quot; improvements.</p></article>
+<footer>Copyright</footer></body></html>`,
+			expected: "Go 1.25 released\nThe Go team is happy to announce Go 1.25.\nIt includes \"many\" improvements.",
+		},
+		{
+			name: "本文を示す要素がない場合は段落の多い要素を抽出する",
+			page: `<html><body>
+<div id="menu"><p>Menu</p><p>Login</p></div>
+<div id="content">
+<p>This is the first paragraph of the article body text.</p>
+<p>This is the second paragraph of the article body text.</p>
+</div>
+<div id="comments"><p>Nice post, thanks for writing this article!</p></div>
+</body></html>`,
+			expected: "This is the first paragraph of the article body text.\nThis is the second paragraph of the article body text.",
+		},
+		{
+			name:        "本文が見つからない場合はエラー",
+			page:        `<html><body><nav>Home</nav></body></html>`,
+			expectError: true,
+		},
+		{
+			name:        "エラーレスポンスの場合はエラー",
+			status:      http.StatusNotFound,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.status != 0 {
+					w.WriteHeader(tt.status)
+					return
+				}
+				_, _ = w.Write([]byte(tt.page))
+			}))
+			defer server.Close()
+
+			extractor, err := NewArticleExtractor(nil)
+			require.NoError(t, err)
+
+			text, err := extractor.Extract(context.Background(), server.URL+"/post")
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, text)
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package fetch
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"github.com/mmcdole/gofeed"
+)
+
+// newHTTPClient はフィード取得設定からHTTPクライアントを作成する
+func newHTTPClient(config *entity.FetchConfig) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	client := &http.Client{Transport: transport, CheckRedirect: checkRedirect}
+	if config == nil {
+		return client, nil
+	}
+
+	// プロキシ未指定の場合は環境変数（HTTPS_PROXYなど）の設定に従う
+	if config.ProxyURL != "" {
+		proxyURL, err := url.Parse(config.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy url: %w", err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	if config.CABundlePath != "" {
+		rootCAs, err := loadCABundle(config.CABundlePath)
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig = &tls.Config{
+			RootCAs:    rootCAs,
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+
+	client.Timeout = config.Timeout
+	return client, nil
+}
+
+// maxRedirects はリダイレクトを追う上限（net/httpのデフォルトと同じ）
+const maxRedirects = 10
+
+// credentialHeadersKey はフィード固有のヘッダーと認証ヘッダーの名前をリクエストのcontextに保存するキー
+type credentialHeadersKey struct{}
+
+// checkRedirect はリダイレクト先のホストが元のリクエストと異なる場合に、フィード固有のヘッダーと認証ヘッダーを削除する
+// net/httpが別のホストへのリダイレクトで削除するのはAuthorizationとCookieのみで、
+// ヘッダー認証（PRIVATE-TOKENなど）やフィード固有のヘッダーはそのまま送信されてしまうため
+func checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= maxRedirects {
+		return errors.New("stopped after 10 redirects")
+	}
+	if strings.EqualFold(req.URL.Host, via[0].URL.Host) {
+		return nil
+	}
+	names, _ := req.Context().Value(credentialHeadersKey{}).([]string)
+	for _, name := range names {
+		req.Header.Del(name)
+	}
+	return nil
+}
+
+// loadCABundle はシステムの証明書プールにPEM形式のCA証明書を追加した証明書プールを返す
+func loadCABundle(path string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA bundle: %w", err)
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no valid certificates found in CA bundle: %s", path)
+	}
+	return pool, nil
+}
+
+// limitedReader は上限を超えて読み込んだ場合に切り詰めずエラーを返すReader
+// 途中で切り詰めると壊れたフィードとして解析されてしまうため
+type limitedReader struct {
+	r    io.Reader
+	left int64
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	n, err := l.r.Read(p)
+	l.left -= int64(n)
+	if l.left < 0 {
+		return n, gofeed.ErrResponseTooLarge
+	}
+	return n, err
+}
@@ -0,0 +1,141 @@
+package fetch
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"github.com/canpok1/ai-feed/internal/infra/cache"
+	"github.com/mmcdole/gofeed"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchClient_FetchConfig(t *testing.T) {
+	t.Run("User-Agentと追加ヘッダーを送信する", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "ai-feed-test/1.0", r.Header.Get("User-Agent"))
+			assert.Equal(t, "value", r.Header.Get("X-Custom"))
+			_, _ = w.Write([]byte(testRSS))
+		}))
+		defer server.Close()
+
+		client, err := NewFetchClient(&entity.FetchConfig{
+			UserAgent: "ai-feed-test/1.0",
+			Headers:   map[string]string{"X-Custom": "value", "User-Agent": "ignored"},
+		}, cache.NewNopFeedStateStore())
+		require.NoError(t, err)
+
+		articles, err := client.Fetch(context.Background(), entity.Feed{URL: server.URL})
+		require.NoError(t, err)
+		assert.Len(t, articles, 1)
+	})
+
+	t.Run("User-Agent未指定の場合はデフォルト値を送信する", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, defaultUserAgent, r.Header.Get("User-Agent"))
+			_, _ = w.Write([]byte(testRSS))
+		}))
+		defer server.Close()
+
+		client, err := NewFetchClient(nil, cache.NewNopFeedStateStore())
+		require.NoError(t, err)
+
+		_, err = client.Fetch(context.Background(), entity.Feed{URL: server.URL})
+		require.NoError(t, err)
+	})
+
+	t.Run("レスポンスが最大サイズを超える場合はエラーを返す", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(testRSS))
+		}))
+		defer server.Close()
+
+		client, err := NewFetchClient(&entity.FetchConfig{MaxBodySize: 32}, cache.NewNopFeedStateStore())
+		require.NoError(t, err)
+
+		_, err = client.Fetch(context.Background(), entity.Feed{URL: server.URL})
+		assert.ErrorIs(t, err, gofeed.ErrResponseTooLarge)
+	})
+
+	t.Run("CAバンドルで指定した証明書を信頼する", func(t *testing.T) {
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(testRSS))
+		}))
+		defer server.Close()
+
+		caPath := filepath.Join(t.TempDir(), "ca.pem")
+		caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+		require.NoError(t, os.WriteFile(caPath, caPEM, 0600))
+
+		withoutCA, err := NewFetchClient(nil, cache.NewNopFeedStateStore())
+		require.NoError(t, err)
+		_, err = withoutCA.Fetch(context.Background(), entity.Feed{URL: server.URL})
+		assert.Error(t, err)
+
+		withCA, err := NewFetchClient(&entity.FetchConfig{CABundlePath: caPath}, cache.NewNopFeedStateStore())
+		require.NoError(t, err)
+		articles, err := withCA.Fetch(context.Background(), entity.Feed{URL: server.URL})
+		require.NoError(t, err)
+		assert.Len(t, articles, 1)
+	})
+}
+
+func TestNewHTTPClient(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      *entity.FetchConfig
+		expectedErr string
+	}{
+		{
+			name:   "設定なし",
+			config: nil,
+		},
+		{
+			name:   "プロキシとタイムアウトを設定",
+			config: &entity.FetchConfig{ProxyURL: "http://proxy.example.com:8080", Timeout: 5 * time.Second},
+		},
+		{
+			name:        "CAバンドルが存在しない",
+			config:      &entity.FetchConfig{CABundlePath: "/nonexistent/ca.pem"},
+			expectedErr: "failed to read CA bundle",
+		},
+		{
+			name: "CAバンドルに証明書が含まれない",
+			config: &entity.FetchConfig{CABundlePath: func() string {
+				path := filepath.Join(t.TempDir(), "invalid.pem")
+				require.NoError(t, os.WriteFile(path, []byte("not a certificate"), 0600))
+				return path
+			}()},
+			expectedErr: "no valid certificates found in CA bundle",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := newHTTPClient(tt.config)
+
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			if tt.config != nil {
+				assert.Equal(t, tt.config.Timeout, client.Timeout)
+			}
+			if tt.config != nil && tt.config.ProxyURL != "" {
+				transport := client.Transport.(*http.Transport)
+				req, _ := http.NewRequest(http.MethodGet, "https://example.com/feed.xml", nil)
+				proxyURL, err := transport.Proxy(req)
+				require.NoError(t, err)
+				assert.Equal(t, tt.config.ProxyURL, proxyURL.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package fetch
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+)
+
+// localFeedExtensions はディレクトリから読み込むフィードファイルの拡張子
+var localFeedExtensions = []string{".xml", ".rss", ".atom", ".json"}
+
+// fetchLocal はローカルのファイル・ディレクトリまたは標準入力からフィードを読み込む
+// ディレクトリの場合は対応する拡張子のファイルをファイル名順にすべて読み込む
+func (f *FetchClient) fetchLocal(feed entity.Feed) ([]entity.Article, error) {
+	if feed.URL == entity.FeedURLStdin {
+		articles, err := f.parseLocal(f.stdin)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read feed from stdin: %w", err)
+		}
+		return articles, nil
+	}
+
+	path, err := localFeedPath(feed.URL)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open feed file: %w", err)
+	}
+	if !info.IsDir() {
+		return f.readFeedFile(path)
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read feed directory %s: %w", path, err)
+	}
+	var articles []entity.Article
+	found := 0
+	for _, entry := range entries {
+		if entry.IsDir() || !slices.Contains(localFeedExtensions, strings.ToLower(filepath.Ext(entry.Name()))) {
+			continue
+		}
+		found++
+		fileArticles, err := f.readFeedFile(filepath.Join(path, entry.Name()))
+		if err != nil {
+			// 1つのファイルが壊れていても他のファイルの記事は使えるようにする
+			slog.Warn("Skipping unreadable feed file", "path", filepath.Join(path, entry.Name()), "error", err)
+			continue
+		}
+		articles = append(articles, fileArticles...)
+	}
+	if found == 0 {
+		return nil, fmt.Errorf("no feed files (%s) found in directory %s", strings.Join(localFeedExtensions, ", "), path)
+	}
+	return articles, nil
+}
+
+// readFeedFile はフィードファイルを読み込んで記事一覧を返す
+func (f *FetchClient) readFeedFile(path string) ([]entity.Article, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open feed file: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	articles, err := f.parseLocal(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse feed file %s: %w", path, err)
+	}
+	return articles, nil
+}
+
+// parseLocal はフィード取得と同じサイズ上限を適用してフィードを解析する
+func (f *FetchClient) parseLocal(r io.Reader) ([]entity.Article, error) {
+	if f.maxBodySize > 0 {
+		r = &limitedReader{r: r, left: f.maxBodySize}
+	}
+	return parseFeed(r)
+}
+
+// localFeedPath はフィードのURLをファイルパスに変換する（file:// のURLの場合はパス部分を返す）
+func localFeedPath(feedURL string) (string, error) {
+	if !strings.HasPrefix(strings.ToLower(feedURL), "file:") {
+		return feedURL, nil
+	}
+
+	parsed, err := url.Parse(feedURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid file URL %s: %w", feedURL, err)
+	}
+	if parsed.Host != "" && parsed.Host != "localhost" {
+		return "", fmt.Errorf("file URL with remote host is not supported: %s", feedURL)
+	}
+	if parsed.Path == "" {
+		// file:relative/path.xml のような形式
+		return parsed.Opaque, nil
+	}
+	return filepath.FromSlash(parsed.Path), nil
+}
@@ -0,0 +1,112 @@
+package fetch
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testJSONFeed = `{
+  "version": "https://jsonfeed.org/version/1.1",
+  "title": "JSON Feed",
+  "items": [
+    {"id": "2", "url": "https://example.com/2", "title": "Article 2", "content_text": "Content 2"}
+  ]
+}`
+
+func TestFetchClient_Fetch_Local(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.xml"), []byte(testRSS), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b.json"), []byte(testJSONFeed), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "broken.rss"), []byte("not a feed"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("ignored"), 0o644))
+	emptyDir := t.TempDir()
+
+	tests := []struct {
+		name      string
+		feedURL   string
+		stdin     string
+		wantLinks []string
+		wantErr   string
+	}{
+		{
+			name:      "ファイルパスから読み込む",
+			feedURL:   filepath.Join(dir, "a.xml"),
+			wantLinks: []string{"https://example.com/1"},
+		},
+		{
+			name:      "file:// のURLから読み込む",
+			feedURL:   "file://" + filepath.ToSlash(filepath.Join(dir, "b.json")),
+			wantLinks: []string{"https://example.com/2"},
+		},
+		{
+			name:      "ディレクトリ内のフィードファイルをファイル名順に読み込み、解析できないファイルは飛ばす",
+			feedURL:   dir,
+			wantLinks: []string{"https://example.com/1", "https://example.com/2"},
+		},
+		{
+			name:      "標準入力から読み込む",
+			feedURL:   entity.FeedURLStdin,
+			stdin:     testRSS,
+			wantLinks: []string{"https://example.com/1"},
+		},
+		{
+			name:    "ファイルが存在しない場合はエラー",
+			feedURL: filepath.Join(dir, "missing.xml"),
+			wantErr: "failed to open feed file",
+		},
+		{
+			name:    "ディレクトリにフィードファイルがない場合はエラー",
+			feedURL: emptyDir,
+			wantErr: "no feed files",
+		},
+		{
+			name:    "標準入力がフィードでない場合はエラー",
+			feedURL: entity.FeedURLStdin,
+			stdin:   "not a feed",
+			wantErr: "failed to read feed from stdin",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// ローカルのフィードは取得状態を使わないため、stateStoreはnilでよい
+			client, err := NewFetchClient(nil, nil)
+			require.NoError(t, err)
+			client.(*FetchClient).stdin = strings.NewReader(tt.stdin)
+
+			articles, err := client.Fetch(context.Background(), entity.Feed{URL: tt.feedURL})
+
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			var links []string
+			for _, article := range articles {
+				links = append(links, article.Link)
+			}
+			assert.Equal(t, tt.wantLinks, links)
+		})
+	}
+}
+
+func TestLocalFeedPath(t *testing.T) {
+	path, err := localFeedPath("file:///var/feeds/feed.xml")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.FromSlash("/var/feeds/feed.xml"), path)
+
+	path, err = localFeedPath("./feeds/feed.xml")
+	require.NoError(t, err)
+	assert.Equal(t, "./feeds/feed.xml", path)
+
+	_, err = localFeedPath("file://server/share/feed.xml")
+	assert.Error(t, err)
+}
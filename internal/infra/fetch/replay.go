@@ -0,0 +1,68 @@
+package fetch
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+
+	"github.com/canpok1/ai-feed/internal/domain"
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"github.com/mmcdole/gofeed"
+)
+
+// ReplayFetchClient は記録済みのスナップショットからフィードを読み込むFetchClient
+// ネットワークにはアクセスせず、記録時と同じレスポンスを再現する
+type ReplayFetchClient struct {
+	store *SnapshotStore
+	// local はローカルのファイル・ディレクトリまたは標準入力を指定したフィードの読み込みに使う
+	local *FetchClient
+}
+
+// NewReplayFetchClient はスナップショットのディレクトリからReplayFetchClientを作成する
+func NewReplayFetchClient(dir string, config *entity.FetchConfig) (domain.FetchClient, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open snapshot directory: %w", err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("snapshot path is not a directory: %s", dir)
+	}
+
+	local := &FetchClient{stdin: os.Stdin}
+	if config != nil {
+		local.maxBodySize = config.MaxBodySize
+	}
+	return &ReplayFetchClient{store: NewSnapshotStore(dir), local: local}, nil
+}
+
+// Fetch はフィードのURLのスナップショットから記事一覧を返す
+// 記録時に失敗したレスポンス（2xx以外）は同じエラーとして返す
+func (r *ReplayFetchClient) Fetch(ctx context.Context, feed entity.Feed) ([]entity.Article, error) {
+	if feed.IsLocal() {
+		return r.local.fetchLocal(feed)
+	}
+
+	snapshot, err := r.store.Load(feed.URL)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("no recorded snapshot for %s", feed.URL)
+		}
+		return nil, err
+	}
+	slog.Debug("Replaying feed snapshot", "url", feed.URL, "fetched_at", snapshot.FetchedAt)
+
+	if snapshot.StatusCode < 200 || snapshot.StatusCode >= 300 {
+		return nil, gofeed.HTTPError{
+			StatusCode: snapshot.StatusCode,
+			Status:     fmt.Sprintf("%d %s", snapshot.StatusCode, http.StatusText(snapshot.StatusCode)),
+		}
+	}
+	return parseFeed(bytes.NewReader(snapshot.Body))
+}
+
+// ReplayFetchClientがFetchClientインターフェースを実装していることを確認する
+var _ domain.FetchClient = (*ReplayFetchClient)(nil)
@@ -0,0 +1,74 @@
+package fetch
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/canpok1/ai-feed/internal/domain"
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"github.com/canpok1/ai-feed/internal/infra/cache"
+	"github.com/mmcdole/gofeed"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/feed", func(w http.ResponseWriter, r *http.Request) {
+		// 記録時は保存済みの検証子があっても条件付きリクエストを送らない
+		assert.Empty(t, r.Header.Get("If-None-Match"))
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte(testRSS))
+	})
+	mux.HandleFunc("/broken", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	feedURL := server.URL + "/feed"
+	brokenURL := server.URL + "/broken"
+
+	stateStore := cache.NewFileFeedStateStore(t.TempDir() + "/feed_state.json")
+	require.NoError(t, stateStore.Initialize())
+	require.NoError(t, stateStore.Update(domain.FeedState{URL: feedURL, ETag: `"v0"`}))
+
+	dir := t.TempDir()
+	recorder, err := NewRecordingFetchClient(nil, stateStore, NewSnapshotStore(dir))
+	require.NoError(t, err)
+	recorded, err := recorder.Fetch(context.Background(), entity.Feed{URL: feedURL})
+	require.NoError(t, err)
+	_, err = recorder.Fetch(context.Background(), entity.Feed{URL: brokenURL})
+	require.Error(t, err)
+
+	snapshot, err := NewSnapshotStore(dir).Load(feedURL)
+	require.NoError(t, err)
+	assert.Equal(t, feedURL, snapshot.URL)
+	assert.Equal(t, http.StatusOK, snapshot.StatusCode)
+	assert.Equal(t, `"v1"`, snapshot.Header.Get("ETag"))
+	assert.Equal(t, testRSS, string(snapshot.Body))
+
+	// 記録後はサーバーに接続できなくても同じ結果を再現する
+	server.Close()
+	replay, err := NewReplayFetchClient(dir, nil)
+	require.NoError(t, err)
+
+	replayed, err := replay.Fetch(context.Background(), entity.Feed{URL: feedURL})
+	require.NoError(t, err)
+	assert.Equal(t, recorded, replayed)
+
+	_, err = replay.Fetch(context.Background(), entity.Feed{URL: brokenURL})
+	var httpErr gofeed.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, http.StatusServiceUnavailable, httpErr.StatusCode)
+
+	_, err = replay.Fetch(context.Background(), entity.Feed{URL: server.URL + "/unknown"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no recorded snapshot")
+}
+
+func TestNewReplayFetchClient_MissingDirectory(t *testing.T) {
+	_, err := NewReplayFetchClient(t.TempDir()+"/missing", nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,93 @@
+package fetch
+
+import (
+	"bytes"
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/canpok1/ai-feed/internal/domain"
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"golang.org/x/net/html"
+)
+
+// URLResolver は記事ページを取得して、リダイレクト先や<link rel="canonical">から正規URLを調べる
+type URLResolver struct {
+	client           *FetchClient
+	followRedirects  bool
+	useCanonicalLink bool
+}
+
+// NewURLResolver はフィード取得設定からHTTPクライアントを構築してURLResolverを作成する
+func NewURLResolver(fetchConfig *entity.FetchConfig, canonicalConfig *entity.CanonicalURLConfig) (domain.URLResolver, error) {
+	client, err := NewFetchClient(fetchConfig, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &URLResolver{
+		client:           client.(*FetchClient),
+		followRedirects:  canonicalConfig.IsFollowRedirectsEnabled(),
+		useCanonicalLink: canonicalConfig.IsCanonicalLinkEnabled(),
+	}, nil
+}
+
+// Resolve は記事の正規URLを返す
+// <link rel="canonical">を優先し、見つからない場合はリダイレクトをたどる設定であればリダイレクト後のURLを返す
+func (r *URLResolver) Resolve(ctx context.Context, articleURL string) (string, error) {
+	body, finalURL, err := r.client.getPage(ctx, entity.Feed{URL: articleURL})
+	if err != nil {
+		return "", err
+	}
+
+	if r.useCanonicalLink {
+		if canonical := findCanonicalLink(body, finalURL); canonical != "" {
+			return canonical, nil
+		}
+	}
+	if r.followRedirects {
+		return finalURL.String(), nil
+	}
+	return articleURL, nil
+}
+
+// findCanonicalLink はページの<link rel="canonical">のURLを返す（見つからない場合は空文字）
+// 相対URLはページのURLを基準に解決する
+func findCanonicalLink(body []byte, pageURL *url.URL) string {
+	doc, err := html.Parse(bytes.NewReader(body))
+	if err != nil {
+		return ""
+	}
+
+	for node := range doc.Descendants() {
+		if node.Type != html.ElementNode || node.Data != "link" || !hasRelCanonical(htmlAttr(node, "rel")) {
+			continue
+		}
+		href := strings.TrimSpace(htmlAttr(node, "href"))
+		if href == "" {
+			continue
+		}
+		ref, err := url.Parse(href)
+		if err != nil {
+			continue
+		}
+		resolved := pageURL.ResolveReference(ref)
+		if resolved.Scheme != "http" && resolved.Scheme != "https" {
+			continue
+		}
+		return resolved.String()
+	}
+	return ""
+}
+
+// hasRelCanonical はrel属性にcanonicalが含まれているかどうかを返す
+func hasRelCanonical(rel string) bool {
+	for _, value := range strings.Fields(rel) {
+		if strings.EqualFold(value, "canonical") {
+			return true
+		}
+	}
+	return false
+}
+
+// URLResolverがURLResolverインターフェースを実装していることを確認する
+var _ domain.URLResolver = (*URLResolver)(nil)
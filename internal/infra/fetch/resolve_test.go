@@ -0,0 +1,76 @@
+package fetch
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"github.com/canpok1/ai-feed/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestURLResolver_Resolve(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/redirect", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/article", http.StatusMovedPermanently)
+	})
+	mux.HandleFunc("/article", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`<html><head><link rel="canonical" href="/canonical"></head><body></body></html>`))
+	})
+	mux.HandleFunc("/plain", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`<html><head><title>no canonical</title></head></html>`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	tests := []struct {
+		name        string
+		config      *entity.CanonicalURLConfig
+		path        string
+		expected    string
+		expectError bool
+	}{
+		{
+			name:     "リダイレクト後のURLを返す",
+			config:   &entity.CanonicalURLConfig{FollowRedirects: testutil.BoolPtr(true)},
+			path:     "/redirect",
+			expected: server.URL + "/article",
+		},
+		{
+			name:     "canonicalリンクを優先する",
+			config:   &entity.CanonicalURLConfig{FollowRedirects: testutil.BoolPtr(true), UseCanonicalLink: testutil.BoolPtr(true)},
+			path:     "/redirect",
+			expected: server.URL + "/canonical",
+		},
+		{
+			name:     "canonicalリンクがなくリダイレクトもたどらない場合は元のURLを返す",
+			config:   &entity.CanonicalURLConfig{UseCanonicalLink: testutil.BoolPtr(true)},
+			path:     "/plain",
+			expected: server.URL + "/plain",
+		},
+		{
+			name:        "ページを取得できない場合はエラー",
+			config:      &entity.CanonicalURLConfig{FollowRedirects: testutil.BoolPtr(true)},
+			path:        "/missing",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolver, err := NewURLResolver(&entity.FetchConfig{}, tt.config)
+			require.NoError(t, err)
+
+			resolved, err := resolver.Resolve(context.Background(), server.URL+tt.path)
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, resolved)
+		})
+	}
+}
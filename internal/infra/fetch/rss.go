@@ -1,26 +1,218 @@
 package fetch
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/canpok1/ai-feed/internal/domain"
 	"github.com/canpok1/ai-feed/internal/domain/entity"
 	"github.com/mmcdole/gofeed"
 )
 
-type FetchClient struct{}
+// defaultUserAgent はフィード取得時に送信するUser-Agent
+const defaultUserAgent = "Gofeed/1.0"
 
-func NewFetchClient() domain.FetchClient {
-	return &FetchClient{}
+type FetchClient struct {
+	httpClient  *http.Client
+	userAgent   string
+	headers     map[string]string
+	maxBodySize int64
+	stateStore  domain.FeedStateStore
+	// stdin はURLに - を指定したフィードの読み込み元
+	stdin io.Reader
+	// recorder は取得したレスポンスのスナップショットの保存先（nilの場合は保存しない）
+	recorder *SnapshotStore
+	// autoDiscover はフィードとして解析できないWebページからフィードを探して取得するかどうか
+	autoDiscover bool
 }
 
-func (f *FetchClient) Fetch(url string) ([]entity.Article, error) {
+// NewFetchClient はフィード取得設定からHTTPクライアントを構築してFetchClientを作成する
+// stateStoreに保存されたETag/Last-Modifiedを使って条件付きリクエストを送信する
+func NewFetchClient(config *entity.FetchConfig, stateStore domain.FeedStateStore) (domain.FetchClient, error) {
+	httpClient, err := newHTTPClient(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create http client: %w", err)
+	}
+
+	client := &FetchClient{
+		httpClient: httpClient,
+		userAgent:  defaultUserAgent,
+		stateStore: stateStore,
+		stdin:      os.Stdin,
+	}
+	if config != nil {
+		if config.UserAgent != "" {
+			client.userAgent = config.UserAgent
+		}
+		client.headers = config.Headers
+		client.maxBodySize = config.MaxBodySize
+		client.autoDiscover = config.IsAutoDiscoverEnabled()
+	}
+	return client, nil
+}
+
+// NewRecordingFetchClient は取得したフィードのレスポンスをrecorderに保存するFetchClientを作成する
+// すべてのレスポンスの本文を保存するため、条件付きリクエストは送信しない
+func NewRecordingFetchClient(config *entity.FetchConfig, stateStore domain.FeedStateStore, recorder *SnapshotStore) (domain.FetchClient, error) {
+	client, err := NewFetchClient(config, stateStore)
+	if err != nil {
+		return nil, err
+	}
+	client.(*FetchClient).recorder = recorder
+	return client, nil
+}
+
+// Fetch はフィードを取得して記事一覧を返す
+// フィードが更新されていない（304 Not Modified）場合は前回取得した本文から記事一覧を返す
+// URLがフィードではなくWebページだった場合、自動検出が有効であればページから見つけたフィードを取得する
+// ローカルのファイル・ディレクトリまたは標準入力を指定したフィードはHTTPを使わずに読み込む
+func (f *FetchClient) Fetch(ctx context.Context, feed entity.Feed) ([]entity.Article, error) {
+	if feed.IsLocal() {
+		return f.fetchLocal(feed)
+	}
+
+	articles, err := f.fetchFeed(ctx, feed, feed.URL)
+	if err == nil || !errors.Is(err, gofeed.ErrFeedTypeNotDetected) {
+		return articles, err
+	}
+
+	if !f.autoDiscover {
+		return nil, fmt.Errorf("%w (if %s is a web page, find its feed URL with 'ai-feed feeds discover %s' or set fetch.auto_discover: true)", err, feed.URL, feed.URL)
+	}
+
+	discovered, discoverErr := f.discover(ctx, feed)
+	if discoverErr != nil {
+		return nil, fmt.Errorf("%w (feed auto-discovery also failed: %v)", err, discoverErr)
+	}
+
+	// 認証情報は設定されたURLと同じオリジンのフィードにのみ送る（ページが別のホストのフィードを示している場合に漏らさないように）
+	discoveredFeed := scopeCredentials(feed, discovered[0].URL)
+	slog.Info("Discovered feed from web page; consider registering the feed URL directly",
+		"page_url", feed.URL,
+		"feed_url", discoveredFeed.URL)
+	return f.fetchFeed(ctx, discoveredFeed, feed.URL)
+}
+
+// fetchFeed はフィードのURLから記事一覧を取得する
+// スナップショットはsourceURL（自動検出したフィードの場合は元のWebページのURL）で保存する
+func (f *FetchClient) fetchFeed(ctx context.Context, feed entity.Feed, sourceURL string) ([]entity.Article, error) {
+	url := feed.URL
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req = f.applyHeaders(req, feed)
+
+	// 304の場合に記事一覧を復元できるように、前回の本文を保存している場合のみ条件付きリクエストを送る
+	state, hasState := f.stateStore.Get(url)
+	if hasState && len(state.Body) > 0 && f.recorder == nil {
+		if state.ETag != "" {
+			req.Header.Set("If-None-Match", state.ETag)
+		}
+		if state.LastModified != "" {
+			req.Header.Set("If-Modified-Since", state.LastModified)
+		}
+	}
+
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotModified {
+		slog.Info("Feed not modified since last fetch", "url", url, "last_fetched_at", state.LastFetchedAt)
+		articles, err := parseFeed(bytes.NewReader(state.Body))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse cached feed body: %w", err)
+		}
+		state.URL = url
+		state.LastFetchedAt = time.Now()
+		f.updateState(state)
+		return articles, nil
+	}
+
+	var body io.Reader = resp.Body
+	if f.maxBodySize > 0 {
+		body = &limitedReader{r: resp.Body, left: f.maxBodySize}
+	}
+	if f.recorder != nil {
+		if body, err = f.record(sourceURL, url, resp, body); err != nil {
+			return nil, err
+		}
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, gofeed.HTTPError{
+			StatusCode: resp.StatusCode,
+			Status:     resp.Status,
+		}
+	}
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+	articles, err := parseFeed(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	// 解析に成功した場合のみ検証子と本文を保存する（壊れたレスポンスで次回の取得がスキップされないように）
+	state.URL = url
+	state.ETag = resp.Header.Get("ETag")
+	state.LastModified = resp.Header.Get("Last-Modified")
+	state.Body = data
+	state.LastFetchedAt = time.Now()
+	f.updateState(state)
+
+	return articles, nil
+}
+
+// record はレスポンスのスナップショットを保存し、読み込んだ本文を返す
+func (f *FetchClient) record(sourceURL, fetchedURL string, resp *http.Response, body io.Reader) (io.Reader, error) {
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := FeedSnapshot{
+		URL:        sourceURL,
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		FetchedAt:  time.Now(),
+		Body:       data,
+	}
+	if fetchedURL != sourceURL {
+		snapshot.FetchedURL = fetchedURL
+	}
+	if err := f.recorder.Save(snapshot); err != nil {
+		return nil, fmt.Errorf("failed to record feed snapshot: %w", err)
+	}
+	slog.Debug("Recorded feed snapshot", "url", sourceURL, "status", resp.StatusCode, "bytes", len(data))
+	return bytes.NewReader(data), nil
+}
+
+// parseFeed はRSS/Atom/JSON Feedを解析して記事一覧を返す
+func parseFeed(body io.Reader) ([]entity.Article, error) {
 	fp := gofeed.NewParser()
-	feed, err := fp.ParseURL(url)
+	parsedFeed, err := fp.Parse(body)
 	if err != nil {
 		return nil, err
 	}
 
 	var articles []entity.Article
-	for _, item := range feed.Items {
+	for _, item := range parsedFeed.Items {
 		content := ""
 		if item.Content != "" {
 			content = item.Content
@@ -29,11 +221,119 @@ func (f *FetchClient) Fetch(url string) ([]entity.Article, error) {
 		}
 
 		articles = append(articles, entity.Article{
-			Title:     item.Title,
-			Link:      item.Link,
-			Published: item.PublishedParsed,
-			Content:   content,
+			Title:      item.Title,
+			Link:       item.Link,
+			Published:  item.PublishedParsed,
+			Updated:    item.UpdatedParsed,
+			Content:    content,
+			Author:     itemAuthor(item),
+			GUID:       item.GUID,
+			ImageURL:   itemImageURL(item),
+			Categories: item.Categories,
+			Enclosures: itemEnclosures(item),
+			FeedTitle:  parsedFeed.Title,
 		})
 	}
 	return articles, nil
 }
+
+// itemAuthor は記事の著者名を返す（複数いる場合はカンマ区切り、名前がない場合はメールアドレス）
+func itemAuthor(item *gofeed.Item) string {
+	persons := item.Authors
+	if len(persons) == 0 && item.Author != nil {
+		persons = []*gofeed.Person{item.Author}
+	}
+
+	var names []string
+	for _, person := range persons {
+		if person == nil {
+			continue
+		}
+		name := strings.TrimSpace(person.Name)
+		if name == "" {
+			name = strings.TrimSpace(person.Email)
+		}
+		if name != "" && !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+// itemImageURL は記事の代表画像のURLを返す
+// 記事の画像、media:thumbnail、画像の添付ファイルの順に探す
+func itemImageURL(item *gofeed.Item) string {
+	if item.Image != nil && item.Image.URL != "" {
+		return item.Image.URL
+	}
+	for _, thumbnail := range item.Extensions["media"]["thumbnail"] {
+		if imageURL := thumbnail.Attrs["url"]; imageURL != "" {
+			return imageURL
+		}
+	}
+	for _, enclosure := range item.Enclosures {
+		if enclosure != nil && enclosure.URL != "" && strings.HasPrefix(enclosure.Type, "image/") {
+			return enclosure.URL
+		}
+	}
+	return ""
+}
+
+// itemEnclosures は記事の添付ファイルを返す（サイズが不正な場合は0とする）
+func itemEnclosures(item *gofeed.Item) []entity.Enclosure {
+	var enclosures []entity.Enclosure
+	for _, enclosure := range item.Enclosures {
+		if enclosure == nil || enclosure.URL == "" {
+			continue
+		}
+		length, _ := strconv.ParseInt(strings.TrimSpace(enclosure.Length), 10, 64)
+		enclosures = append(enclosures, entity.Enclosure{
+			URL:    enclosure.URL,
+			Type:   enclosure.Type,
+			Length: length,
+		})
+	}
+	return enclosures
+}
+
+// applyHeaders は共通ヘッダー、フィード固有のヘッダー、認証情報の順にリクエストへ設定する
+// 別のホストへのリダイレクトで削除できるように、フィード固有のヘッダーと認証ヘッダーの名前をcontextに保存したリクエストを返す
+func (f *FetchClient) applyHeaders(req *http.Request, feed entity.Feed) *http.Request {
+	for name, value := range f.headers {
+		req.Header.Set(name, value)
+	}
+	req.Header.Set("User-Agent", f.userAgent)
+
+	var credentialHeaders []string
+	for name, value := range feed.Headers {
+		req.Header.Set(name, value)
+		credentialHeaders = append(credentialHeaders, name)
+	}
+
+	if feed.Auth != nil {
+		switch feed.Auth.Type {
+		case entity.FeedAuthTypeBasic:
+			req.SetBasicAuth(feed.Auth.Username, feed.Auth.Password.Value())
+			credentialHeaders = append(credentialHeaders, "Authorization")
+		case entity.FeedAuthTypeBearer:
+			req.Header.Set("Authorization", "Bearer "+feed.Auth.Token.Value())
+			credentialHeaders = append(credentialHeaders, "Authorization")
+		case entity.FeedAuthTypeHeader:
+			req.Header.Set(feed.Auth.HeaderName, feed.Auth.Token.Value())
+			credentialHeaders = append(credentialHeaders, feed.Auth.HeaderName)
+		}
+	}
+
+	if len(credentialHeaders) == 0 {
+		return req
+	}
+	return req.WithContext(context.WithValue(req.Context(), credentialHeadersKey{}, credentialHeaders))
+}
+
+// updateState はフィードの取得状態を保存する
+// 保存に失敗しても次回が通常のリクエストになるだけなので、警告ログに留める
+func (f *FetchClient) updateState(state domain.FeedState) {
+	if err := f.stateStore.Update(state); err != nil {
+		slog.Warn("Failed to update feed state", "url", state.URL, "error", err)
+	}
+}
@@ -0,0 +1,345 @@
+package fetch
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/canpok1/ai-feed/internal/domain"
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"github.com/canpok1/ai-feed/internal/infra/cache"
+	"github.com/mmcdole/gofeed"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRSS = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0">
+<channel>
+<title>Test Feed</title>
+<item>
+<title>Article 1</title>
+<link>https://example.com/1</link>
+<description>Description 1</description>
+<pubDate>Mon, 01 Jan 2024 00:00:00 GMT</pubDate>
+</item>
+</channel>
+</rss>`
+
+func TestFetchClient_Fetch(t *testing.T) {
+	tests := []struct {
+		name          string
+		savedState    *domain.FeedState
+		handler       http.HandlerFunc
+		wantArticles  int
+		wantErrStatus int
+		wantETag      string
+	}{
+		{
+			name: "初回取得時は検証子を保存する",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				assert.Empty(t, r.Header.Get("If-None-Match"))
+				assert.Empty(t, r.Header.Get("If-Modified-Since"))
+				w.Header().Set("ETag", `"v1"`)
+				w.Header().Set("Last-Modified", "Mon, 01 Jan 2024 00:00:00 GMT")
+				_, _ = w.Write([]byte(testRSS))
+			},
+			wantArticles: 1,
+			wantETag:     `"v1"`,
+		},
+		{
+			name: "保存済みの検証子で条件付きリクエストを送り、304は前回の本文から記事一覧を返す",
+			savedState: &domain.FeedState{
+				ETag:         `"v1"`,
+				LastModified: "Mon, 01 Jan 2024 00:00:00 GMT",
+				Body:         []byte(testRSS),
+			},
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, `"v1"`, r.Header.Get("If-None-Match"))
+				assert.Equal(t, "Mon, 01 Jan 2024 00:00:00 GMT", r.Header.Get("If-Modified-Since"))
+				w.WriteHeader(http.StatusNotModified)
+			},
+			wantArticles: 1,
+			wantETag:     `"v1"`,
+		},
+		{
+			name:       "前回の本文を保存していない場合は条件付きリクエストを送らない",
+			savedState: &domain.FeedState{ETag: `"v1"`},
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				assert.Empty(t, r.Header.Get("If-None-Match"))
+				w.Header().Set("ETag", `"v1"`)
+				_, _ = w.Write([]byte(testRSS))
+			},
+			wantArticles: 1,
+			wantETag:     `"v1"`,
+		},
+		{
+			name:       "フィードが更新されている場合は新しい検証子を保存する",
+			savedState: &domain.FeedState{ETag: `"v1"`},
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("ETag", `"v2"`)
+				_, _ = w.Write([]byte(testRSS))
+			},
+			wantArticles: 1,
+			wantETag:     `"v2"`,
+		},
+		{
+			name:       "エラーレスポンスの場合は状態を更新しない",
+			savedState: &domain.FeedState{ETag: `"v1"`},
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			},
+			wantErrStatus: http.StatusInternalServerError,
+			wantETag:      `"v1"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(tt.handler)
+			defer server.Close()
+
+			store := cache.NewFileFeedStateStore(t.TempDir() + "/feed_state.json")
+			require.NoError(t, store.Initialize())
+			if tt.savedState != nil {
+				state := *tt.savedState
+				state.URL = server.URL
+				require.NoError(t, store.Update(state))
+			}
+
+			before := time.Now()
+			client, err := NewFetchClient(nil, store)
+			require.NoError(t, err)
+			articles, err := client.Fetch(context.Background(), entity.Feed{URL: server.URL})
+
+			if tt.wantErrStatus != 0 {
+				var httpErr gofeed.HTTPError
+				require.ErrorAs(t, err, &httpErr)
+				assert.Equal(t, tt.wantErrStatus, httpErr.StatusCode)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Len(t, articles, tt.wantArticles)
+
+			state, ok := store.Get(server.URL)
+			require.True(t, ok)
+			assert.Equal(t, tt.wantETag, state.ETag)
+			if tt.wantErrStatus == 0 {
+				assert.False(t, state.LastFetchedAt.Before(before))
+			}
+		})
+	}
+}
+
+func TestFetchClient_Fetch_NotModifiedOnSecondRun(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte(testRSS))
+	}))
+	defer server.Close()
+
+	statePath := t.TempDir() + "/feed_state.json"
+	feed := entity.Feed{URL: server.URL}
+
+	// 実行ごとに状態を読み込み、取得と健全性の記録をして書き出す
+	run := func() []entity.Article {
+		store := cache.NewFileFeedStateStore(statePath)
+		require.NoError(t, store.Initialize())
+		defer func() { require.NoError(t, store.Close()) }()
+
+		client, err := NewFetchClient(nil, store)
+		require.NoError(t, err)
+		articles, err := client.Fetch(context.Background(), feed)
+		require.NoError(t, err)
+		domain.NewFeedHealthMonitor(store, nil).RecordSuccess(feed.URL, len(articles))
+		return articles
+	}
+
+	first := run()
+	second := run()
+	assert.Equal(t, first, second)
+	assert.Len(t, second, 1)
+
+	store := cache.NewFileFeedStateStore(statePath)
+	require.NoError(t, store.Initialize())
+	state, ok := store.Get(server.URL)
+	require.True(t, ok)
+	assert.Equal(t, 1, state.LastItemCount)
+}
+
+func TestFetchClient_FeedAuth(t *testing.T) {
+	tests := []struct {
+		name         string
+		feed         entity.Feed
+		assertHeader func(t *testing.T, r *http.Request)
+	}{
+		{
+			name: "Basic認証",
+			feed: entity.Feed{Auth: &entity.FeedAuth{
+				Type:     entity.FeedAuthTypeBasic,
+				Username: "user",
+				Password: entity.NewSecretString("pass"),
+			}},
+			assertHeader: func(t *testing.T, r *http.Request) {
+				username, password, ok := r.BasicAuth()
+				assert.True(t, ok)
+				assert.Equal(t, "user", username)
+				assert.Equal(t, "pass", password)
+			},
+		},
+		{
+			name: "Bearer認証",
+			feed: entity.Feed{Auth: &entity.FeedAuth{
+				Type:  entity.FeedAuthTypeBearer,
+				Token: entity.NewSecretString("token"),
+			}},
+			assertHeader: func(t *testing.T, r *http.Request) {
+				assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+			},
+		},
+		{
+			name: "ヘッダー認証とフィード固有のヘッダー",
+			feed: entity.Feed{
+				Auth: &entity.FeedAuth{
+					Type:       entity.FeedAuthTypeHeader,
+					HeaderName: "PRIVATE-TOKEN",
+					Token:      entity.NewSecretString("token"),
+				},
+				Headers: map[string]string{"X-Common": "feed"},
+			},
+			assertHeader: func(t *testing.T, r *http.Request) {
+				assert.Equal(t, "token", r.Header.Get("PRIVATE-TOKEN"))
+				// フィード固有のヘッダーは共通ヘッダーより優先される
+				assert.Equal(t, "feed", r.Header.Get("X-Common"))
+				assert.Empty(t, r.Header.Get("Authorization"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				tt.assertHeader(t, r)
+				_, _ = w.Write([]byte(testRSS))
+			}))
+			defer server.Close()
+
+			client, err := NewFetchClient(&entity.FetchConfig{
+				Headers: map[string]string{"X-Common": "global"},
+			}, cache.NewNopFeedStateStore())
+			require.NoError(t, err)
+
+			feed := tt.feed
+			feed.URL = server.URL
+			articles, err := client.Fetch(context.Background(), feed)
+			require.NoError(t, err)
+			assert.Len(t, articles, 1)
+		})
+	}
+}
+
+func TestFetchClient_FeedAuthRedirect(t *testing.T) {
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// 別のホストにはフィードの認証情報とヘッダーを送らない
+		assert.Empty(t, r.Header.Get("PRIVATE-TOKEN"))
+		assert.Empty(t, r.Header.Get("X-Feed"))
+		assert.Equal(t, "ai-feed-test", r.Header.Get("User-Agent"))
+		_, _ = w.Write([]byte(testRSS))
+	}))
+	defer other.Close()
+
+	origin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/same":
+			http.Redirect(w, r, "/feed.xml", http.StatusFound)
+		case "/other":
+			http.Redirect(w, r, other.URL+"/feed.xml", http.StatusFound)
+		case "/feed.xml":
+			// 同じホストへのリダイレクトでは認証情報を送る
+			assert.Equal(t, "token", r.Header.Get("PRIVATE-TOKEN"))
+			assert.Equal(t, "feed", r.Header.Get("X-Feed"))
+			_, _ = w.Write([]byte(testRSS))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer origin.Close()
+
+	for _, path := range []string{"/same", "/other"} {
+		t.Run(path, func(t *testing.T) {
+			client, err := NewFetchClient(&entity.FetchConfig{UserAgent: "ai-feed-test"}, cache.NewNopFeedStateStore())
+			require.NoError(t, err)
+
+			articles, err := client.Fetch(context.Background(), entity.Feed{
+				URL: origin.URL + path,
+				Auth: &entity.FeedAuth{
+					Type:       entity.FeedAuthTypeHeader,
+					HeaderName: "PRIVATE-TOKEN",
+					Token:      entity.NewSecretString("token"),
+				},
+				Headers: map[string]string{"X-Feed": "feed"},
+			})
+			require.NoError(t, err)
+			assert.Len(t, articles, 1)
+		})
+	}
+}
+
+func TestFetchClient_FetchItemMetadata(t *testing.T) {
+	const feedXML = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:media="http://search.yahoo.com/mrss/" xmlns:dc="http://purl.org/dc/elements/1.1/">
+<channel>
+<title>Example Blog</title>
+<item>
+<title>With thumbnail</title>
+<link>https://example.com/1</link>
+<guid>urn:example:1</guid>
+<dc:creator>Alice</dc:creator>
+<category>Go</category>
+<category>Release</category>
+<pubDate>Mon, 01 Jan 2024 00:00:00 GMT</pubDate>
+<media:thumbnail url="https://example.com/thumb.png"/>
+<enclosure url="https://example.com/episode.mp3" type="audio/mpeg" length="1234"/>
+</item>
+<item>
+<title>With image enclosure</title>
+<link>https://example.com/2</link>
+<enclosure url="https://example.com/cover.jpg" type="image/jpeg" length="invalid"/>
+</item>
+</channel>
+</rss>`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(feedXML))
+	}))
+	defer server.Close()
+
+	client, err := NewFetchClient(nil, cache.NewNopFeedStateStore())
+	require.NoError(t, err)
+	articles, err := client.Fetch(context.Background(), entity.Feed{URL: server.URL})
+	require.NoError(t, err)
+	require.Len(t, articles, 2)
+
+	first := articles[0]
+	assert.Equal(t, "Alice", first.Author)
+	assert.Equal(t, "urn:example:1", first.GUID)
+	assert.Equal(t, []string{"Go", "Release"}, first.Categories)
+	assert.Equal(t, "https://example.com/thumb.png", first.ImageURL)
+	assert.Equal(t, []entity.Enclosure{
+		{URL: "https://example.com/episode.mp3", Type: "audio/mpeg", Length: 1234},
+	}, first.Enclosures)
+	assert.Equal(t, "Example Blog", first.FeedTitle)
+
+	second := articles[1]
+	assert.Equal(t, "https://example.com/cover.jpg", second.ImageURL)
+	assert.Equal(t, []entity.Enclosure{
+		{URL: "https://example.com/cover.jpg", Type: "image/jpeg", Length: 0},
+	}, second.Enclosures)
+	assert.Equal(t, "Example Blog", second.FeedTitle)
+}
@@ -0,0 +1,78 @@
+package fetch
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// FeedSnapshot はフィード取得時のレスポンスを記録したもの
+type FeedSnapshot struct {
+	URL        string      `json:"url"`
+	FetchedURL string      `json:"fetched_url,omitempty"` // 自動検出したフィードを取得した場合のフィードのURL
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header"`
+	FetchedAt  time.Time   `json:"fetched_at"`
+	Body       []byte      `json:"-"`
+}
+
+// SnapshotStore はフィードのスナップショットをディレクトリに保存・読み込みする
+// フィードごとにURLのSHA-256ハッシュ値を名前とするレスポンス情報（.json）と本文（.body）のファイルを作成する
+type SnapshotStore struct {
+	dir string
+}
+
+// NewSnapshotStore はSnapshotStoreを作成する
+func NewSnapshotStore(dir string) *SnapshotStore {
+	return &SnapshotStore{dir: dir}
+}
+
+// Save はスナップショットを保存する（同じURLのスナップショットは上書きする）
+func (s *SnapshotStore) Save(snapshot FeedSnapshot) error {
+	if err := os.MkdirAll(s.dir, 0755); err != nil {
+		return fmt.Errorf("failed to create snapshot directory %s: %w", s.dir, err)
+	}
+
+	meta, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode snapshot of %s: %w", snapshot.URL, err)
+	}
+	base := s.basePath(snapshot.URL)
+	if err := os.WriteFile(base+".body", snapshot.Body, 0644); err != nil {
+		return fmt.Errorf("failed to write snapshot of %s: %w", snapshot.URL, err)
+	}
+	if err := os.WriteFile(base+".json", meta, 0644); err != nil {
+		return fmt.Errorf("failed to write snapshot of %s: %w", snapshot.URL, err)
+	}
+	return nil
+}
+
+// Load はURLのスナップショットを読み込む（記録されていない場合はos.ErrNotExistを返す）
+func (s *SnapshotStore) Load(feedURL string) (*FeedSnapshot, error) {
+	base := s.basePath(feedURL)
+	meta, err := os.ReadFile(base + ".json")
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshot FeedSnapshot
+	if err := json.Unmarshal(meta, &snapshot); err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot of %s: %w", feedURL, err)
+	}
+	snapshot.Body, err = os.ReadFile(base + ".body")
+	if err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
+
+// basePath はURLのスナップショットのファイルパス（拡張子なし）を返す
+func (s *SnapshotStore) basePath(feedURL string) string {
+	sum := sha256.Sum256([]byte(feedURL))
+	return filepath.Join(s.dir, hex.EncodeToString(sum[:]))
+}
@@ -0,0 +1,62 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+)
+
+// ChainEntry はAIの呼び出し順（chain）のプロバイダ名と、そのプロバイダの実装の組
+type ChainEntry[T any] struct {
+	Provider string
+	Value    T
+}
+
+// BuildChain は呼び出し順のプロバイダごとにbuildで実装を作成する
+// 設定がない、または不正なプロバイダは警告を出して飛ばし、1つも作成できない場合はエラーを返す
+func BuildChain[T any](task string, providers []string, build func(provider string) (T, error)) ([]ChainEntry[T], error) {
+	var entries []ChainEntry[T]
+	for _, provider := range providers {
+		value, err := build(provider)
+		if err != nil {
+			slog.Warn("Skipping AI provider in chain",
+				slog.String("task", task),
+				slog.String("provider", provider),
+				slog.Any("error", err))
+			continue
+		}
+		entries = append(entries, ChainEntry[T]{Provider: provider, Value: value})
+	}
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("no usable AI provider in chain: %s", strings.Join(providers, ", "))
+	}
+	return entries, nil
+}
+
+// CallChain は呼び出し順にfnを呼び出し、最初に成功した結果を返す（すべて失敗した場合は最後のエラーを返す）
+// キャンセルや期限切れの場合は次のプロバイダを試さない
+func CallChain[T, R any](ctx context.Context, task string, entries []ChainEntry[T], fn func(T) (R, error)) (R, error) {
+	var zero R
+	var lastErr error
+	for i, entry := range entries {
+		if i > 0 {
+			slog.Warn("Falling back to next AI provider",
+				slog.String("task", task),
+				slog.String("from", entries[i-1].Provider),
+				slog.String("to", entry.Provider),
+				slog.Any("error", lastErr))
+		}
+
+		result, err := fn(entry.Value)
+		if err == nil {
+			return result, nil
+		}
+		lastErr = err
+
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return zero, lastErr
+}
@@ -0,0 +1,95 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildChain(t *testing.T) {
+	build := func(provider string) (string, error) {
+		if provider == "gemini" {
+			return "", errors.New("invalid gemini config")
+		}
+		return provider + "-client", nil
+	}
+
+	entries, err := BuildChain("test", []string{"gemini", "openai", "mock"}, build)
+	require.NoError(t, err)
+	assert.Equal(t, []ChainEntry[string]{
+		{Provider: "openai", Value: "openai-client"},
+		{Provider: "mock", Value: "mock-client"},
+	}, entries)
+
+	_, err = BuildChain("test", []string{"gemini"}, build)
+	assert.EqualError(t, err, "no usable AI provider in chain: gemini")
+}
+
+func TestCallChain(t *testing.T) {
+	unavailable := errors.New("503 Service Unavailable")
+	canceledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		results   map[string]error // プロバイダごとの結果（nilは成功）
+		want      string
+		wantErr   error
+		wantCalls []string
+	}{
+		{
+			name:      "最初のプロバイダで成功した場合はその結果を返す",
+			ctx:       context.Background(),
+			results:   map[string]error{"gemini": nil},
+			want:      "gemini",
+			wantCalls: []string{"gemini"},
+		},
+		{
+			name:      "失敗した場合は次のプロバイダを使う",
+			ctx:       context.Background(),
+			results:   map[string]error{"gemini": unavailable, "openai": nil},
+			want:      "openai",
+			wantCalls: []string{"gemini", "openai"},
+		},
+		{
+			name:      "すべて失敗した場合は最後のエラーを返す",
+			ctx:       context.Background(),
+			results:   map[string]error{"gemini": unavailable, "openai": context.DeadlineExceeded},
+			wantErr:   context.DeadlineExceeded,
+			wantCalls: []string{"gemini", "openai"},
+		},
+		{
+			name:      "キャンセルされた場合は次のプロバイダを試さない",
+			ctx:       canceledCtx,
+			results:   map[string]error{"gemini": context.Canceled},
+			wantErr:   context.Canceled,
+			wantCalls: []string{"gemini"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries := []ChainEntry[string]{{Provider: "gemini", Value: "gemini"}, {Provider: "openai", Value: "openai"}}
+			var calls []string
+			got, err := CallChain(tt.ctx, "test", entries, func(provider string) (string, error) {
+				calls = append(calls, provider)
+				if err := tt.results[provider]; err != nil {
+					return "", err
+				}
+				return provider, nil
+			})
+
+			assert.Equal(t, tt.wantCalls, calls)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/canpok1/ai-feed/internal/domain"
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"google.golang.org/genai"
+)
+
+// geminiClient はGemini APIのdomain.LLMClientのアダプタ
+type geminiClient struct {
+	client    *genai.Client
+	modelType string
+}
+
+func newGeminiClient(aiConfig *entity.AIConfig, model string) (domain.LLMClient, error) {
+	client, err := genai.NewClient(context.Background(), &genai.ClientConfig{
+		APIKey:  aiConfig.Gemini.APIKey.Value(),
+		Backend: genai.BackendGeminiAPI,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gemini client: %w", err)
+	}
+	return &geminiClient{client: client, modelType: model}, nil
+}
+
+func (g *geminiClient) GenerateText(ctx context.Context, req domain.LLMRequest) (string, error) {
+	return g.generate(ctx, req, &genai.GenerateContentConfig{})
+}
+
+func (g *geminiClient) GenerateJSON(ctx context.Context, req domain.LLMRequest, schema *domain.JSONSchema) (string, error) {
+	return g.generate(ctx, req, &genai.GenerateContentConfig{
+		ResponseMIMEType: "application/json",
+		ResponseSchema:   toGeminiSchema(schema),
+	})
+}
+
+func (g *geminiClient) generate(ctx context.Context, req domain.LLMRequest, config *genai.GenerateContentConfig) (string, error) {
+	if req.SystemPrompt != "" {
+		config.SystemInstruction = genai.NewContentFromText(req.SystemPrompt, "")
+	}
+
+	resp, err := g.client.Models.GenerateContent(ctx, g.modelType, genai.Text(req.Prompt), config)
+	if err != nil {
+		return "", err
+	}
+	if len(resp.Candidates) == 0 || resp.Candidates[0].Content == nil || len(resp.Candidates[0].Content.Parts) == 0 {
+		return "", fmt.Errorf("no content generated")
+	}
+	return resp.Text(), nil
+}
+
+// toGeminiSchema はJSONスキーマをGemini APIのスキーマに変換する
+func toGeminiSchema(schema *domain.JSONSchema) *genai.Schema {
+	if schema == nil {
+		return nil
+	}
+	result := &genai.Schema{
+		Type:        genai.Type(strings.ToUpper(schema.Type)),
+		Description: schema.Description,
+		Required:    schema.Required,
+		Minimum:     schema.Minimum,
+		Maximum:     schema.Maximum,
+	}
+	if len(schema.Properties) > 0 {
+		result.Properties = make(map[string]*genai.Schema, len(schema.Properties))
+		for name, property := range schema.Properties {
+			result.Properties[name] = toGeminiSchema(property)
+		}
+	}
+	return result
+}
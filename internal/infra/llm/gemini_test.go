@@ -0,0 +1,29 @@
+package llm
+
+import (
+	"testing"
+
+	"github.com/canpok1/ai-feed/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genai"
+)
+
+func TestToGeminiSchema(t *testing.T) {
+	minimum, maximum := 0.0, 2.0
+	schema := toGeminiSchema(&domain.JSONSchema{
+		Type: "object",
+		Properties: map[string]*domain.JSONSchema{
+			"selected_index": {Type: "integer", Description: "インデックス", Minimum: &minimum, Maximum: &maximum},
+		},
+		Required: []string{"selected_index"},
+	})
+
+	assert.Equal(t, &genai.Schema{
+		Type: genai.TypeObject,
+		Properties: map[string]*genai.Schema{
+			"selected_index": {Type: genai.TypeInteger, Description: "インデックス", Minimum: &minimum, Maximum: &maximum},
+		},
+		Required: []string{"selected_index"},
+	}, schema)
+	assert.Nil(t, toGeminiSchema(nil))
+}
@@ -0,0 +1,44 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/canpok1/ai-feed/internal/domain"
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"github.com/canpok1/ai-feed/internal/infra/ollama"
+)
+
+// ollamaClient はOllamaのネイティブAPIのdomain.LLMClientのアダプタ
+type ollamaClient struct {
+	client *ollama.Client
+}
+
+func newOllamaClient(aiConfig *entity.AIConfig, model string) (domain.LLMClient, error) {
+	return &ollamaClient{client: ollama.NewClient(aiConfig.Ollama, model)}, nil
+}
+
+// GenerateText は/api/generateで文章を生成する
+func (o *ollamaClient) GenerateText(ctx context.Context, req domain.LLMRequest) (string, error) {
+	return o.client.Generate(ctx, req.Prompt, req.SystemPrompt)
+}
+
+// GenerateJSON は/api/chatのformatにJSONスキーマを指定して、スキーマに従ったJSONを生成する
+func (o *ollamaClient) GenerateJSON(ctx context.Context, req domain.LLMRequest, schema *domain.JSONSchema) (string, error) {
+	// スキーマがない場合は形式を指定せずにJSONを要求する
+	format := json.RawMessage(`"json"`)
+	if schema != nil {
+		var err error
+		if format, err = json.Marshal(schema); err != nil {
+			return "", fmt.Errorf("failed to encode schema: %w", err)
+		}
+	}
+
+	var messages []ollama.Message
+	if req.SystemPrompt != "" {
+		messages = append(messages, ollama.Message{Role: "system", Content: req.SystemPrompt})
+	}
+	messages = append(messages, ollama.Message{Role: "user", Content: req.Prompt})
+	return o.client.Chat(ctx, messages, format)
+}
@@ -0,0 +1,117 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/canpok1/ai-feed/internal/domain"
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOllamaClient_GenerateJSON(t *testing.T) {
+	maximum := 1.0
+	schema := &domain.JSONSchema{
+		Type:       "object",
+		Properties: map[string]*domain.JSONSchema{"selected_index": {Type: "integer", Maximum: &maximum}},
+		Required:   []string{"selected_index"},
+	}
+
+	tests := []struct {
+		name           string
+		status         int
+		wantErrContain string
+	}{
+		{name: "formatにスキーマを指定して/api/chatで生成する"},
+		{
+			name:           "Ollamaのエラーメッセージを返す",
+			status:         http.StatusNotFound,
+			wantErrContain: `model "llama3.1" not found`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/chat", r.URL.Path)
+
+				var req struct {
+					Model     string          `json:"model"`
+					Messages  []any           `json:"messages"`
+					Format    json.RawMessage `json:"format"`
+					Stream    *bool           `json:"stream"`
+					KeepAlive any             `json:"keep_alive"`
+				}
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+				assert.Equal(t, "llama3.1", req.Model)
+				assert.Len(t, req.Messages, 2)
+				if assert.NotNil(t, req.Stream) {
+					assert.False(t, *req.Stream)
+				}
+				// -1 は数値として送る
+				assert.Equal(t, float64(-1), req.KeepAlive)
+				assert.JSONEq(t, `{"type":"object","properties":{"selected_index":{"type":"integer","maximum":1}},"required":["selected_index"]}`, string(req.Format))
+
+				if tt.status != 0 {
+					w.WriteHeader(tt.status)
+					_, _ = w.Write([]byte(`{"error":"model \"llama3.1\" not found, try pulling it first"}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{"model":"llama3.1","message":{"role":"assistant","content":"{\"selected_index\": 1}"},"done":true}`))
+			}))
+			defer server.Close()
+
+			client, err := newOllamaClient(&entity.AIConfig{
+				Ollama: &entity.OllamaConfig{BaseURL: server.URL, Model: "llama3.1", KeepAlive: "-1"},
+			}, "llama3.1")
+			require.NoError(t, err)
+
+			text, err := client.GenerateJSON(context.Background(), domain.LLMRequest{SystemPrompt: "system", Prompt: "prompt"}, schema)
+			if tt.wantErrContain != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErrContain)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, `{"selected_index": 1}`, text)
+		})
+	}
+}
+
+func TestOllamaClient_GenerateText(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/generate", r.URL.Path)
+
+		var req struct {
+			Model     string `json:"model"`
+			Prompt    string `json:"prompt"`
+			System    string `json:"system"`
+			Stream    *bool  `json:"stream"`
+			KeepAlive any    `json:"keep_alive"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "llama3.1", req.Model)
+		assert.Equal(t, "記事", req.Prompt)
+		assert.Equal(t, "system", req.System)
+		if assert.NotNil(t, req.Stream) {
+			assert.False(t, *req.Stream)
+		}
+		assert.Equal(t, "10m", req.KeepAlive)
+
+		_, _ = w.Write([]byte(`{"model":"llama3.1","response":"面白い記事です","done":true}`))
+	}))
+	defer server.Close()
+
+	client, err := newOllamaClient(&entity.AIConfig{
+		Ollama: &entity.OllamaConfig{BaseURL: server.URL, Model: "llama3.1", KeepAlive: "10m"},
+	}, "llama3.1")
+	require.NoError(t, err)
+
+	text, err := client.GenerateText(context.Background(), domain.LLMRequest{SystemPrompt: "system", Prompt: "記事"})
+	require.NoError(t, err)
+	assert.Equal(t, "面白い記事です", text)
+}
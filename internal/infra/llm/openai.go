@@ -0,0 +1,44 @@
+package llm
+
+import (
+	"context"
+
+	"github.com/canpok1/ai-feed/internal/domain"
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"github.com/canpok1/ai-feed/internal/infra/openai"
+)
+
+// openAIClient はOpenAI互換のChat Completions APIのdomain.LLMClientのアダプタ
+type openAIClient struct {
+	client   *openai.Client
+	jsonMode bool
+}
+
+func newOpenAIClient(aiConfig *entity.AIConfig, model string) (domain.LLMClient, error) {
+	return &openAIClient{
+		client:   openai.NewClient(aiConfig.OpenAI, model),
+		jsonMode: aiConfig.OpenAI.IsJSONModeEnabled(),
+	}, nil
+}
+
+func (o *openAIClient) GenerateText(ctx context.Context, req domain.LLMRequest) (string, error) {
+	return o.client.Complete(ctx, openAIMessages(req), false)
+}
+
+// GenerateJSON はJSON形式の応答を要求する
+// response_formatのjson_objectはスキーマを指定できないため、形式はプロンプトで伝える必要がある
+func (o *openAIClient) GenerateJSON(ctx context.Context, req domain.LLMRequest, _ *domain.JSONSchema) (string, error) {
+	text, err := o.client.Complete(ctx, openAIMessages(req), o.jsonMode)
+	if err != nil {
+		return "", err
+	}
+	return openai.ExtractJSON(text), nil
+}
+
+func openAIMessages(req domain.LLMRequest) []openai.Message {
+	var messages []openai.Message
+	if req.SystemPrompt != "" {
+		messages = append(messages, openai.Message{Role: "system", Content: req.SystemPrompt})
+	}
+	return append(messages, openai.Message{Role: "user", Content: req.Prompt})
+}
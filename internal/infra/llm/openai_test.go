@@ -0,0 +1,118 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/canpok1/ai-feed/internal/domain"
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"github.com/canpok1/ai-feed/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenAIClient(t *testing.T) {
+	tests := []struct {
+		name           string
+		json           bool
+		jsonMode       *bool
+		content        string
+		status         int
+		want           string
+		wantFormat     string
+		wantErrContain string
+	}{
+		{
+			name:    "文章を生成する",
+			content: "面白い記事です",
+			want:    "面白い記事です",
+		},
+		{
+			name:       "JSONを生成する場合はresponse_formatを要求する",
+			json:       true,
+			content:    `{"selected_index": 1}`,
+			want:       `{"selected_index": 1}`,
+			wantFormat: "json_object",
+		},
+		{
+			name:     "JSONモードを無効にした場合はコードブロック内のJSONを取り出す",
+			json:     true,
+			jsonMode: testutil.BoolPtr(false),
+			content:  "```json\n{\"selected_index\": 0}\n```",
+			want:     `{"selected_index": 0}`,
+		},
+		{
+			name:           "APIのエラーメッセージを返す",
+			status:         http.StatusUnauthorized,
+			wantErrContain: "Incorrect API key provided",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/v1/chat/completions", r.URL.Path)
+				assert.Equal(t, "Bearer test-key", r.Header.Get("Authorization"))
+
+				var req struct {
+					Model          string           `json:"model"`
+					Messages       []openAIMessage  `json:"messages"`
+					ResponseFormat *json.RawMessage `json:"response_format"`
+				}
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+				assert.Equal(t, "gpt-4o-mini", req.Model)
+				assert.Equal(t, []openAIMessage{{Role: "system", Content: "system"}, {Role: "user", Content: "prompt"}}, req.Messages)
+				if tt.wantFormat == "" {
+					assert.Nil(t, req.ResponseFormat)
+				} else if assert.NotNil(t, req.ResponseFormat) {
+					assert.JSONEq(t, `{"type":"`+tt.wantFormat+`"}`, string(*req.ResponseFormat))
+				}
+
+				if tt.status != 0 {
+					w.WriteHeader(tt.status)
+					_, _ = w.Write([]byte(`{"error":{"message":"Incorrect API key provided"}}`))
+					return
+				}
+				resp := map[string]any{
+					"choices": []any{map[string]any{"message": map[string]any{"role": "assistant", "content": tt.content}}},
+				}
+				_ = json.NewEncoder(w).Encode(resp)
+			}))
+			defer server.Close()
+
+			client, err := newOpenAIClient(&entity.AIConfig{
+				OpenAI: &entity.OpenAIConfig{
+					BaseURL:  server.URL + "/v1",
+					Model:    "gpt-4o-mini",
+					APIKey:   entity.NewSecretString("test-key"),
+					JSONMode: tt.jsonMode,
+				},
+			}, "gpt-4o-mini")
+			require.NoError(t, err)
+
+			req := domain.LLMRequest{SystemPrompt: "system", Prompt: "prompt"}
+			var text string
+			if tt.json {
+				text, err = client.GenerateJSON(context.Background(), req, &domain.JSONSchema{Type: "object"})
+			} else {
+				text, err = client.GenerateText(context.Background(), req)
+			}
+			if tt.wantErrContain != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErrContain)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, text)
+		})
+	}
+}
+
+// openAIMessage はリクエストのメッセージを検証するための型
+type openAIMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
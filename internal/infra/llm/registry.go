@@ -0,0 +1,134 @@
+package llm
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/canpok1/ai-feed/internal/domain"
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+)
+
+// Provider はLLMのプロバイダを表す
+// プロバイダを追加する場合は、domain.LLMClientのアダプタを実装してprovidersに登録する
+type Provider struct {
+	// Name はプロバイダ名（AI設定のキーと同じ名前）
+	Name string
+	// config はAI設定からこのプロバイダの設定を取り出す（設定がない場合はnilを返す）
+	config func(*entity.AIConfig) validatable
+	// models は使用するモデルを試す順に返す（フォールバックのモデルを含む）
+	models func(*entity.AIConfig) []string
+	// newClient は検証済みのAI設定から、指定したモデルを使うクライアントを作成する
+	newClient func(aiConfig *entity.AIConfig, model string) (domain.LLMClient, error)
+}
+
+// validatable はプロバイダの設定が実装するバリデーションのインターフェース
+type validatable interface {
+	Validate() *entity.ValidationResult
+}
+
+// providers は登録済みのプロバイダ（AI設定に複数のプロバイダの設定がある場合はこの順に優先する）
+var providers = []Provider{
+	{
+		Name: "openai",
+		config: func(c *entity.AIConfig) validatable {
+			if c.OpenAI == nil {
+				return nil
+			}
+			return c.OpenAI
+		},
+		models: func(c *entity.AIConfig) []string {
+			return append([]string{c.OpenAI.Model}, c.OpenAI.FallbackModels...)
+		},
+		newClient: newOpenAIClient,
+	},
+	{
+		Name: "ollama",
+		config: func(c *entity.AIConfig) validatable {
+			if c.Ollama == nil {
+				return nil
+			}
+			return c.Ollama
+		},
+		models: func(c *entity.AIConfig) []string {
+			return append([]string{c.Ollama.Model}, c.Ollama.FallbackModels...)
+		},
+		newClient: newOllamaClient,
+	},
+	{
+		Name: "gemini",
+		config: func(c *entity.AIConfig) validatable {
+			if c.Gemini == nil {
+				return nil
+			}
+			return c.Gemini
+		},
+		models: func(c *entity.AIConfig) []string {
+			return append([]string{c.Gemini.Type}, c.Gemini.FallbackModels...)
+		},
+		newClient: newGeminiClient,
+	},
+}
+
+// Lookup はプロバイダ名からプロバイダを返す
+func Lookup(name string) (Provider, bool) {
+	for _, provider := range providers {
+		if provider.Name == name {
+			return provider, true
+		}
+	}
+	return Provider{}, false
+}
+
+// IsConfigured はAI設定にこのプロバイダの設定が含まれているかどうかを返す
+func (p Provider) IsConfigured(aiConfig *entity.AIConfig) bool {
+	return aiConfig != nil && p.config(aiConfig) != nil
+}
+
+// NewClient はAI設定を検証し、このプロバイダのクライアントを作成する
+// クライアントは一時的なエラーをリトライし、失敗した場合はフォールバックのモデルを順に試す
+func (p Provider) NewClient(aiConfig *entity.AIConfig) (domain.LLMClient, error) {
+	if !p.IsConfigured(aiConfig) {
+		return nil, fmt.Errorf("%s config is nil", p.Name)
+	}
+	if result := p.config(aiConfig).Validate(); !result.IsValid {
+		return nil, fmt.Errorf("invalid %s config: %s", p.Name, strings.Join(result.Errors, "; "))
+	}
+
+	var models []modelClient
+	for _, model := range p.models(aiConfig) {
+		model = strings.TrimSpace(model)
+		if model == "" || slices.ContainsFunc(models, func(m modelClient) bool { return m.model == model }) {
+			continue
+		}
+		client, err := p.newClient(aiConfig, model)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, modelClient{model: model, client: client})
+	}
+	return newRetryClient(p.Name, models, aiConfig.Retry), nil
+}
+
+// NewClient はAI設定に含まれるプロバイダのうち、優先度の最も高いプロバイダのクライアントを作成する
+// 優先度はprovidersの順（openai、ollama、gemini）で、複数のプロバイダが設定されている場合は使用するプロバイダを警告に出力する
+func NewClient(aiConfig *entity.AIConfig) (domain.LLMClient, error) {
+	var configured []string
+	for _, provider := range providers {
+		if provider.IsConfigured(aiConfig) {
+			configured = append(configured, provider.Name)
+		}
+	}
+	if len(configured) == 0 {
+		return nil, fmt.Errorf("no supported AI configuration found")
+	}
+
+	selected, _ := Lookup(configured[0])
+	if len(configured) > 1 {
+		slog.Warn("Multiple AI providers are configured without ai.chain; using the highest priority provider",
+			slog.String("provider", selected.Name),
+			slog.Any("configured", configured))
+	}
+	return selected.NewClient(aiConfig)
+}
@@ -0,0 +1,122 @@
+package llm
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/canpok1/ai-feed/internal/domain"
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewClient(t *testing.T) {
+	gemini := &entity.GeminiConfig{
+		Type:           "gemini-2.5-flash",
+		APIKey:         entity.NewSecretString("test-key"),
+		FallbackModels: []string{"gemini-2.0-flash", "gemini-2.5-flash", " "},
+	}
+	openAI := &entity.OpenAIConfig{BaseURL: "http://localhost:8000/v1", Model: "local"}
+	ollama := &entity.OllamaConfig{Model: "llama3.1"}
+
+	tests := []struct {
+		name         string
+		config       *entity.AIConfig
+		wantType     domain.LLMClient
+		wantProvider string
+		wantModels   []string
+		errString    string
+	}{
+		{
+			name:         "Geminiはフォールバックのモデルを重複と空を除いて順に使う",
+			config:       &entity.AIConfig{Gemini: gemini},
+			wantType:     &geminiClient{},
+			wantProvider: "gemini",
+			wantModels:   []string{"gemini-2.5-flash", "gemini-2.0-flash"},
+		},
+		{
+			name:         "OpenAI互換APIはGeminiより優先する",
+			config:       &entity.AIConfig{Gemini: gemini, OpenAI: openAI},
+			wantType:     &openAIClient{},
+			wantProvider: "openai",
+			wantModels:   []string{"local"},
+		},
+		{
+			name:         "OllamaはGeminiより優先する",
+			config:       &entity.AIConfig{Gemini: gemini, Ollama: ollama},
+			wantType:     &ollamaClient{},
+			wantProvider: "ollama",
+			wantModels:   []string{"llama3.1"},
+		},
+		{
+			name:      "設定を検証する",
+			config:    &entity.AIConfig{Ollama: &entity.OllamaConfig{}},
+			errString: "invalid ollama config: Ollama設定のモデル名が設定されていません",
+		},
+		{
+			name:      "プロバイダの設定がない",
+			config:    &entity.AIConfig{},
+			errString: "no supported AI configuration found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := NewClient(tt.config)
+			if tt.errString != "" {
+				require.Error(t, err)
+				assert.Equal(t, tt.errString, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.IsType(t, &retryClient{}, client)
+			retry := client.(*retryClient)
+			assert.Equal(t, tt.wantProvider, retry.provider)
+			var models []string
+			for _, m := range retry.models {
+				models = append(models, m.model)
+				assert.IsType(t, tt.wantType, m.client)
+			}
+			assert.Equal(t, tt.wantModels, models)
+		})
+	}
+}
+
+func TestNewClient_WarnsWhenMultipleProvidersConfigured(t *testing.T) {
+	var logBuffer bytes.Buffer
+	originalLogger := slog.Default()
+	slog.SetDefault(slog.New(slog.NewJSONHandler(&logBuffer, &slog.HandlerOptions{Level: slog.LevelInfo})))
+	defer slog.SetDefault(originalLogger)
+
+	_, err := NewClient(&entity.AIConfig{Ollama: &entity.OllamaConfig{Model: "llama3.1"}})
+	require.NoError(t, err)
+	assert.Empty(t, logBuffer.String())
+
+	_, err = NewClient(&entity.AIConfig{
+		Gemini: &entity.GeminiConfig{Type: "gemini-2.5-flash", APIKey: entity.NewSecretString("test-key")},
+		Ollama: &entity.OllamaConfig{Model: "llama3.1"},
+	})
+	require.NoError(t, err)
+
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(logBuffer.Bytes(), &entry))
+	assert.Equal(t, "WARN", entry["level"])
+	assert.Equal(t, "ollama", entry["provider"])
+	assert.Equal(t, []any{"ollama", "gemini"}, entry["configured"])
+}
+
+func TestLookup(t *testing.T) {
+	provider, ok := Lookup("ollama")
+	require.True(t, ok)
+	assert.Equal(t, "ollama", provider.Name)
+	assert.True(t, provider.IsConfigured(&entity.AIConfig{Ollama: &entity.OllamaConfig{}}))
+	assert.False(t, provider.IsConfigured(&entity.AIConfig{}))
+
+	_, err := provider.NewClient(&entity.AIConfig{})
+	assert.EqualError(t, err, "ollama config is nil")
+
+	_, ok = Lookup("unknown")
+	assert.False(t, ok)
+}
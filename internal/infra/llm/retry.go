@@ -0,0 +1,229 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/canpok1/ai-feed/internal/domain"
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"github.com/canpok1/ai-feed/internal/infra/ollama"
+	"github.com/canpok1/ai-feed/internal/infra/openai"
+	"google.golang.org/genai"
+)
+
+// retryableStatusCodes はリトライするHTTPステータスコード（レート制限とサーバー側の一時的なエラー）
+var retryableStatusCodes = []int{
+	http.StatusRequestTimeout,
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// modelClient はモデルとそのモデルを使うクライアントの組
+type modelClient struct {
+	model  string
+	client domain.LLMClient
+}
+
+// retryClient は一時的なエラーで失敗した呼び出しをリトライし、それでも失敗した場合は次のモデルで呼び出すdomain.LLMClient
+type retryClient struct {
+	provider        string
+	models          []modelClient
+	maxAttempts     int
+	initialInterval time.Duration
+	maxInterval     time.Duration
+	sleep           func(ctx context.Context, d time.Duration) error
+}
+
+func newRetryClient(provider string, models []modelClient, config *entity.AIRetryConfig) *retryClient {
+	return &retryClient{
+		provider:        provider,
+		models:          models,
+		maxAttempts:     config.MaxAttemptsOrDefault(),
+		initialInterval: config.InitialIntervalOrDefault(),
+		maxInterval:     config.MaxIntervalOrDefault(),
+		sleep:           sleepContext,
+	}
+}
+
+func (c *retryClient) GenerateText(ctx context.Context, req domain.LLMRequest) (string, error) {
+	return c.call(ctx, func(client domain.LLMClient) (string, error) {
+		return client.GenerateText(ctx, req)
+	})
+}
+
+func (c *retryClient) GenerateJSON(ctx context.Context, req domain.LLMRequest, schema *domain.JSONSchema) (string, error) {
+	return c.call(ctx, func(client domain.LLMClient) (string, error) {
+		return client.GenerateJSON(ctx, req, schema)
+	})
+}
+
+// call はモデルを順に試し、最初に成功した結果を返す（すべて失敗した場合は最後のエラーを返す）
+// 次のモデルを試すのは一時的なエラーの場合のみで、リクエストや認証の誤りなどのエラーはそのまま返す
+func (c *retryClient) call(ctx context.Context, fn func(domain.LLMClient) (string, error)) (string, error) {
+	var lastErr error
+	for i, m := range c.models {
+		if i > 0 {
+			slog.Warn("Falling back to next AI model",
+				slog.String("provider", c.provider),
+				slog.String("from", c.models[i-1].model),
+				slog.String("to", m.model),
+				slog.Any("error", lastErr))
+		}
+
+		text, err := c.callWithRetry(ctx, m, fn)
+		if err == nil {
+			return text, nil
+		}
+		lastErr = err
+
+		// キャンセルや期限切れの場合は次のモデルも試さない
+		if ctx.Err() != nil {
+			break
+		}
+		// 不正なリクエストやAPIキーの誤りなどは他のモデルでも失敗するため試さない
+		if retryable, _ := classifyError(err); !retryable {
+			break
+		}
+	}
+	return "", lastErr
+}
+
+// callWithRetry は1つのモデルで呼び出し、一時的なエラーの場合は待ち時間を空けてリトライする
+// APIが指定した待ち時間がmaxIntervalより長い場合は待たずにエラーを返し、次のモデルに任せる
+func (c *retryClient) callWithRetry(ctx context.Context, m modelClient, fn func(domain.LLMClient) (string, error)) (string, error) {
+	for attempt := 1; ; attempt++ {
+		text, err := fn(m.client)
+		if err == nil {
+			return text, nil
+		}
+
+		retryable, retryAfter := classifyError(err)
+		if !retryable || attempt >= c.maxAttempts || ctx.Err() != nil {
+			return "", err
+		}
+
+		if retryAfter > c.maxInterval {
+			slog.Warn("Retry-After exceeds the max retry interval",
+				slog.String("provider", c.provider),
+				slog.String("model", m.model),
+				slog.Int("attempt", attempt),
+				slog.Duration("retry_after", retryAfter),
+				slog.Duration("max_interval", c.maxInterval),
+				slog.Any("error", err))
+			return "", err
+		}
+
+		wait := max(c.backoff(attempt), retryAfter)
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+			slog.Warn("Giving up AI request retry before the deadline",
+				slog.String("provider", c.provider),
+				slog.String("model", m.model),
+				slog.Int("attempt", attempt),
+				slog.Duration("wait", wait),
+				slog.Any("error", err))
+			return "", err
+		}
+
+		slog.Warn("Retrying AI request",
+			slog.String("provider", c.provider),
+			slog.String("model", m.model),
+			slog.Int("attempt", attempt),
+			slog.Int("max_attempts", c.maxAttempts),
+			slog.Duration("wait", wait),
+			slog.Any("error", err))
+		if sleepErr := c.sleep(ctx, wait); sleepErr != nil {
+			return "", errors.Join(err, sleepErr)
+		}
+	}
+}
+
+// backoff はattempt回目の失敗の後の待ち時間を返す
+// 待ち時間は失敗のたびに倍になり（上限あり）、同時に失敗した呼び出しが重ならないように後半をランダムにする
+func (c *retryClient) backoff(attempt int) time.Duration {
+	wait := c.initialInterval
+	for i := 1; i < attempt && wait < c.maxInterval; i++ {
+		wait *= 2
+	}
+	wait = min(wait, c.maxInterval)
+	half := wait / 2
+	return half + rand.N(half+1)
+}
+
+// classifyError はエラーがリトライで回復する見込みがあるかどうかと、APIが指定した待ち時間を返す
+func classifyError(err error) (bool, time.Duration) {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false, 0
+	}
+
+	var openAIErr *openai.StatusError
+	if errors.As(err, &openAIErr) {
+		return isRetryableStatus(openAIErr.StatusCode), parseRetryAfter(openAIErr.Header.Get("Retry-After"))
+	}
+	var ollamaErr *ollama.StatusError
+	if errors.As(err, &ollamaErr) {
+		return isRetryableStatus(ollamaErr.StatusCode), parseRetryAfter(ollamaErr.Header.Get("Retry-After"))
+	}
+	var geminiErr genai.APIError
+	if errors.As(err, &geminiErr) {
+		return isRetryableStatus(geminiErr.Code), geminiRetryDelay(geminiErr)
+	}
+
+	// 接続エラーやタイムアウトなどの通信エラー
+	var netErr net.Error
+	return errors.As(err, &netErr), 0
+}
+
+func isRetryableStatus(statusCode int) bool {
+	return slices.Contains(retryableStatusCodes, statusCode)
+}
+
+// parseRetryAfter はRetry-Afterヘッダー（秒数またはHTTP日付）から待ち時間を返す
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return max(time.Until(at), 0)
+	}
+	return 0
+}
+
+// geminiRetryDelay はGemini APIのエラーの詳細（google.rpc.RetryInfo）から待ち時間を返す
+func geminiRetryDelay(apiErr genai.APIError) time.Duration {
+	for _, detail := range apiErr.Details {
+		if detail["@type"] != "type.googleapis.com/google.rpc.RetryInfo" {
+			continue
+		}
+		if delay, ok := detail["retryDelay"].(string); ok {
+			if d, err := time.ParseDuration(delay); err == nil {
+				return d
+			}
+		}
+	}
+	return 0
+}
+
+// sleepContext はdだけ待つ（待っている間にキャンセルされた場合はcontextのエラーを返す）
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,210 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/canpok1/ai-feed/internal/domain"
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"github.com/canpok1/ai-feed/internal/domain/mock_domain"
+	"github.com/canpok1/ai-feed/internal/infra/ollama"
+	"github.com/canpok1/ai-feed/internal/infra/openai"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/genai"
+)
+
+func TestRetryClient(t *testing.T) {
+	unavailable := &openai.StatusError{StatusCode: http.StatusServiceUnavailable, Status: "503 Service Unavailable"}
+	rateLimited := &openai.StatusError{
+		StatusCode: http.StatusTooManyRequests,
+		Status:     "429 Too Many Requests",
+		Header:     http.Header{"Retry-After": []string{"3"}},
+	}
+	rateLimitedLong := &openai.StatusError{
+		StatusCode: http.StatusTooManyRequests,
+		Status:     "429 Too Many Requests",
+		Header:     http.Header{"Retry-After": []string{"20"}},
+	}
+	unauthorized := &openai.StatusError{StatusCode: http.StatusUnauthorized, Status: "401 Unauthorized"}
+
+	tests := []struct {
+		name        string
+		primary     []error // プライマリのモデルの呼び出しごとの結果（nilは成功）
+		fallback    []error // フォールバックのモデルの呼び出しごとの結果
+		want        string
+		wantErr     error
+		wantAtLeast time.Duration // 最初の待ち時間の下限
+	}{
+		{
+			name:    "成功した場合はリトライしない",
+			primary: []error{nil},
+			want:    "primary",
+		},
+		{
+			name:    "一時的なエラーはリトライする",
+			primary: []error{unavailable, unavailable, nil},
+			want:    "primary",
+		},
+		{
+			name:        "Retry-Afterの待ち時間に従う",
+			primary:     []error{rateLimited, nil},
+			want:        "primary",
+			wantAtLeast: 3 * time.Second,
+		},
+		{
+			name:     "Retry-Afterが待ち時間の上限より長い場合は待たずに次のモデルを使う",
+			primary:  []error{rateLimitedLong},
+			fallback: []error{nil},
+			want:     "fallback",
+		},
+		{
+			name:     "リトライしても失敗した場合は次のモデルを使う",
+			primary:  []error{unavailable, unavailable, unavailable},
+			fallback: []error{nil},
+			want:     "fallback",
+		},
+		{
+			name:    "リトライしないエラーは次のモデルを使わない",
+			primary: []error{unauthorized},
+			wantErr: unauthorized,
+		},
+		{
+			name:     "すべてのモデルで失敗した場合は最後のエラーを返す",
+			primary:  []error{unavailable, unavailable, unavailable},
+			fallback: []error{unavailable, unavailable, unavailable},
+			wantErr:  unavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			primary := mock_domain.NewMockLLMClient(ctrl)
+			for _, err := range tt.primary {
+				primary.EXPECT().GenerateText(gomock.Any(), gomock.Any()).Return(resultText("primary", err), err)
+			}
+			fallback := mock_domain.NewMockLLMClient(ctrl)
+			for _, err := range tt.fallback {
+				fallback.EXPECT().GenerateText(gomock.Any(), gomock.Any()).Return(resultText("fallback", err), err)
+			}
+
+			client := newRetryClient("openai", []modelClient{
+				{model: "primary", client: primary},
+				{model: "fallback", client: fallback},
+			}, &entity.AIRetryConfig{MaxAttempts: 3, InitialInterval: time.Second, MaxInterval: 4 * time.Second})
+			var waits []time.Duration
+			client.sleep = func(_ context.Context, d time.Duration) error {
+				waits = append(waits, d)
+				return nil
+			}
+
+			text, err := client.GenerateText(context.Background(), domain.LLMRequest{Prompt: "prompt"})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, text)
+			for _, wait := range waits {
+				assert.LessOrEqual(t, wait, 4*time.Second)
+			}
+			if tt.wantAtLeast > 0 && assert.NotEmpty(t, waits) {
+				assert.GreaterOrEqual(t, waits[0], tt.wantAtLeast)
+			}
+		})
+	}
+}
+
+func TestRetryClient_Deadline(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	primary := mock_domain.NewMockLLMClient(ctrl)
+	rateLimited := &ollama.StatusError{
+		StatusCode: http.StatusTooManyRequests,
+		Status:     "429 Too Many Requests",
+		Header:     http.Header{"Retry-After": []string{"60"}},
+	}
+	primary.EXPECT().GenerateText(gomock.Any(), gomock.Any()).Return("", rateLimited)
+
+	client := newRetryClient("ollama", []modelClient{{model: "primary", client: primary}}, nil)
+	client.sleep = func(context.Context, time.Duration) error {
+		t.Fatal("期限までに間に合わない場合は待たない")
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, err := client.GenerateText(ctx, domain.LLMRequest{Prompt: "prompt"})
+	assert.ErrorIs(t, err, rateLimited)
+}
+
+func TestRetryClient_Backoff(t *testing.T) {
+	client := newRetryClient("gemini", nil, &entity.AIRetryConfig{InitialInterval: time.Second, MaxInterval: 3 * time.Second})
+
+	for attempt, want := range map[int]time.Duration{1: time.Second, 2: 2 * time.Second, 3: 3 * time.Second, 10: 3 * time.Second} {
+		wait := client.backoff(attempt)
+		assert.GreaterOrEqual(t, wait, want/2, attempt)
+		assert.LessOrEqual(t, wait, want, attempt)
+	}
+}
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            error
+		wantRetryable  bool
+		wantRetryAfter time.Duration
+	}{
+		{
+			name:          "OpenAI互換APIの503",
+			err:           &openai.StatusError{StatusCode: http.StatusServiceUnavailable},
+			wantRetryable: true,
+		},
+		{
+			name:           "OllamaのRetry-After",
+			err:            &ollama.StatusError{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": []string{"5"}}},
+			wantRetryable:  true,
+			wantRetryAfter: 5 * time.Second,
+		},
+		{
+			name: "Gemini APIのRetryInfo",
+			err: genai.APIError{Code: http.StatusTooManyRequests, Details: []map[string]any{
+				{"@type": "type.googleapis.com/google.rpc.RetryInfo", "retryDelay": "31s"},
+			}},
+			wantRetryable:  true,
+			wantRetryAfter: 31 * time.Second,
+		},
+		{
+			name: "Gemini APIの400",
+			err:  genai.APIError{Code: http.StatusBadRequest},
+		},
+		{
+			name: "キャンセル",
+			err:  context.Canceled,
+		},
+		{
+			name: "その他のエラー",
+			err:  errors.New("no content generated"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			retryable, retryAfter := classifyError(tt.err)
+			assert.Equal(t, tt.wantRetryable, retryable)
+			assert.Equal(t, tt.wantRetryAfter, retryAfter)
+		})
+	}
+}
+
+// resultText は呼び出しが成功した場合の応答を返す
+func resultText(text string, err error) string {
+	if err != nil {
+		return ""
+	}
+	return text
+}
@@ -75,7 +75,7 @@ func TestNewMessageBuilder_WithAliases(t *testing.T) {
 		},
 		{
 			name:          "存在しない別名でエラー",
-			template:      "{{AUTHOR}}",
+			template:      "{{UNKNOWN}}",
 			expectError:   true,
 			errorContains: "存在しないパラメータです",
 		},
@@ -250,6 +250,20 @@ func TestMessageBuilder_BuildRecommendMessage_WithAliases(t *testing.T) {
 			fixedMessage: "追加メッセージ",
 			expected:     "フルテスト\nhttps://full.test\n完全なテスト内容\nおすすめの記事です\n追加メッセージ",
 		},
+		{
+			name:     "記事のメタデータの別名記法",
+			template: "{{TITLE}} by {{AUTHOR}} [{{CATEGORIES}}] via {{FEED_TITLE}}\n{{IMAGE_URL}}",
+			recommend: &entity.Recommend{
+				Article: entity.Article{
+					Title:      "メタデータテスト",
+					Author:     "Alice",
+					Categories: []string{"Go", "Release"},
+					ImageURL:   "https://example.com/image.png",
+					FeedTitle:  "Example Blog",
+				},
+			},
+			expected: "メタデータテスト by Alice [Go, Release] via Example Blog\nhttps://example.com/image.png",
+		},
 	}
 
 	for _, tt := range tests {
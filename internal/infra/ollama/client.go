@@ -0,0 +1,183 @@
+package ollama
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+)
+
+// requestTimeout は1回のリクエストのタイムアウト（モデルの読み込みを含むため長めにする）
+const requestTimeout = 5 * time.Minute
+
+// maxErrorBodySize はエラーメッセージに含めるレスポンスボディの最大バイト数
+const maxErrorBodySize = 1024
+
+// Message は/api/chatに送信するメッセージ
+type Message struct {
+	Role    string `json:"role"` // "system", "user", "assistant"
+	Content string `json:"content"`
+}
+
+// chatRequest は/api/chatのリクエストボディ
+type chatRequest struct {
+	Model     string          `json:"model"`
+	Messages  []Message       `json:"messages"`
+	Format    json.RawMessage `json:"format,omitempty"`
+	Stream    bool            `json:"stream"`
+	KeepAlive any             `json:"keep_alive,omitempty"`
+}
+
+// chatResponse は/api/chatのレスポンスボディ（使用する項目のみ）
+type chatResponse struct {
+	Message Message `json:"message"`
+}
+
+// generateRequest は/api/generateのリクエストボディ
+type generateRequest struct {
+	Model     string `json:"model"`
+	Prompt    string `json:"prompt"`
+	System    string `json:"system,omitempty"`
+	Stream    bool   `json:"stream"`
+	KeepAlive any    `json:"keep_alive,omitempty"`
+}
+
+// generateResponse は/api/generateのレスポンスボディ（使用する項目のみ）
+type generateResponse struct {
+	Response string `json:"response"`
+}
+
+// errorResponse はOllamaのエラーレスポンス
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// Client はOllamaのネイティブAPI（/api/chat、/api/generate）のクライアント
+type Client struct {
+	httpClient *http.Client
+	baseURL    string
+	model      string
+	keepAlive  any
+}
+
+// NewClient はOllamaの設定から、指定したモデルを使うClientを作成する
+func NewClient(config *entity.OllamaConfig, model string) *Client {
+	return &Client{
+		httpClient: &http.Client{Timeout: requestTimeout},
+		baseURL:    config.BaseURLOrDefault(),
+		model:      model,
+		keepAlive:  keepAliveValue(config.KeepAlive),
+	}
+}
+
+// Chat は/api/chatにメッセージを送信し、応答本文を返す
+// formatにJSONスキーマを指定すると、スキーマに従ったJSON形式の応答を要求する
+func (c *Client) Chat(ctx context.Context, messages []Message, format json.RawMessage) (string, error) {
+	var result chatResponse
+	err := c.post(ctx, "/api/chat", chatRequest{
+		Model:     c.model,
+		Messages:  messages,
+		Format:    format,
+		KeepAlive: c.keepAlive,
+	}, &result)
+	if err != nil {
+		return "", err
+	}
+	if strings.TrimSpace(result.Message.Content) == "" {
+		return "", fmt.Errorf("no content generated")
+	}
+	return result.Message.Content, nil
+}
+
+// Generate は/api/generateにプロンプトを送信し、応答本文を返す
+func (c *Client) Generate(ctx context.Context, prompt, system string) (string, error) {
+	var result generateResponse
+	err := c.post(ctx, "/api/generate", generateRequest{
+		Model:     c.model,
+		Prompt:    prompt,
+		System:    system,
+		KeepAlive: c.keepAlive,
+	}, &result)
+	if err != nil {
+		return "", err
+	}
+	if strings.TrimSpace(result.Response) == "" {
+		return "", fmt.Errorf("no content generated")
+	}
+	return result.Response, nil
+}
+
+// post はリクエストボディをJSONで送信し、レスポンスボディをresultにデコードする（ストリーミングは使わない）
+func (c *Client) post(ctx context.Context, path string, body any, result any) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+path, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return newStatusError(path, resp)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// StatusError は2xx以外のレスポンスを表すエラー（リトライの判定に使う）
+type StatusError struct {
+	Path       string
+	StatusCode int
+	Status     string
+	Header     http.Header
+	Message    string // Ollamaのエラーメッセージ（ない場合はレスポンスボディ）
+}
+
+func (e *StatusError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("ollama %s request failed: %s: %s", e.Path, e.Status, e.Message)
+	}
+	return fmt.Sprintf("ollama %s request failed: %s", e.Path, e.Status)
+}
+
+// newStatusError は2xx以外のレスポンスからエラーを作成する（Ollamaのエラーメッセージがあれば含める）
+func newStatusError(path string, resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	message := strings.TrimSpace(string(body))
+	var apiErr errorResponse
+	if json.Unmarshal(body, &apiErr) == nil && apiErr.Error != "" {
+		message = apiErr.Error
+	}
+	return &StatusError{Path: path, StatusCode: resp.StatusCode, Status: resp.Status, Header: resp.Header, Message: message}
+}
+
+// keepAliveValue はkeep_aliveの設定値をリクエストに含める値に変換する
+// Ollamaは数値を秒数、文字列を時間の形式として扱うため、整数の場合は数値で送る
+func keepAliveValue(keepAlive string) any {
+	if keepAlive == "" {
+		return nil
+	}
+	if seconds, err := strconv.Atoi(keepAlive); err == nil {
+		return seconds
+	}
+	return keepAlive
+}
@@ -0,0 +1,151 @@
+package openai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+)
+
+// requestTimeout は1回のリクエストのタイムアウト（ローカルモデルは応答に時間がかかるため長めにする）
+const requestTimeout = 3 * time.Minute
+
+// maxErrorBodySize はエラーメッセージに含めるレスポンスボディの最大バイト数
+const maxErrorBodySize = 1024
+
+// Message はChat Completions APIに送信するメッセージ
+type Message struct {
+	Role    string `json:"role"` // "system", "user", "assistant"
+	Content string `json:"content"`
+}
+
+// responseFormat は応答の形式の指定
+type responseFormat struct {
+	Type string `json:"type"`
+}
+
+// chatRequest はChat Completions APIのリクエストボディ
+type chatRequest struct {
+	Model          string          `json:"model"`
+	Messages       []Message       `json:"messages"`
+	ResponseFormat *responseFormat `json:"response_format,omitempty"`
+}
+
+// chatResponse はChat Completions APIのレスポンスボディ（使用する項目のみ）
+type chatResponse struct {
+	Choices []struct {
+		Message Message `json:"message"`
+	} `json:"choices"`
+}
+
+// errorResponse はAPIのエラーレスポンス
+type errorResponse struct {
+	Error struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// Client はOpenAI互換のChat Completions API（/chat/completions）のクライアント
+type Client struct {
+	httpClient *http.Client
+	baseURL    string
+	model      string
+	apiKey     entity.SecretString
+}
+
+// NewClient はOpenAI互換APIの設定から、指定したモデルを使うClientを作成する
+func NewClient(config *entity.OpenAIConfig, model string) *Client {
+	return &Client{
+		httpClient: &http.Client{Timeout: requestTimeout},
+		baseURL:    config.BaseURLOrDefault(),
+		model:      model,
+		apiKey:     config.APIKey,
+	}
+}
+
+// Complete はメッセージを送信し、最初の候補の応答本文を返す
+// jsonModeがtrueの場合はJSON形式の応答（response_format: json_object）を要求する
+func (c *Client) Complete(ctx context.Context, messages []Message, jsonMode bool) (string, error) {
+	reqBody := chatRequest{
+		Model:    c.model,
+		Messages: messages,
+	}
+	if jsonMode {
+		reqBody.ResponseFormat = &responseFormat{Type: "json_object"}
+	}
+	data, err := json.Marshal(reqBody)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/chat/completions", bytes.NewReader(data))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if !c.apiKey.IsEmpty() {
+		req.Header.Set("Authorization", "Bearer "+c.apiKey.Value())
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", newStatusError(resp)
+	}
+
+	var result chatResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("failed to decode response: %w", err)
+	}
+	if len(result.Choices) == 0 || strings.TrimSpace(result.Choices[0].Message.Content) == "" {
+		return "", fmt.Errorf("no content generated")
+	}
+	return result.Choices[0].Message.Content, nil
+}
+
+// StatusError は2xx以外のレスポンスを表すエラー（リトライの判定に使う）
+type StatusError struct {
+	StatusCode int
+	Status     string
+	Header     http.Header
+	Message    string // APIのエラーメッセージ（ない場合はレスポンスボディ）
+}
+
+func (e *StatusError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("chat completions request failed: %s: %s", e.Status, e.Message)
+	}
+	return fmt.Sprintf("chat completions request failed: %s", e.Status)
+}
+
+// newStatusError は2xx以外のレスポンスからエラーを作成する（APIのエラーメッセージがあれば含める）
+func newStatusError(resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	message := strings.TrimSpace(string(body))
+	var apiErr errorResponse
+	if json.Unmarshal(body, &apiErr) == nil && apiErr.Error.Message != "" {
+		message = apiErr.Error.Message
+	}
+	return &StatusError{StatusCode: resp.StatusCode, Status: resp.Status, Header: resp.Header, Message: message}
+}
+
+// ExtractJSON は応答本文からJSONオブジェクトの部分を取り出す
+// JSONモードに対応していないモデルは ```json のコードブロックや説明文を付けることがあるため、最初の { から最後の } までを返す
+func ExtractJSON(text string) string {
+	start := strings.Index(text, "{")
+	end := strings.LastIndex(text, "}")
+	if start < 0 || end < start {
+		return text
+	}
+	return text[start : end+1]
+}
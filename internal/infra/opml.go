@@ -0,0 +1,169 @@
+package infra
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/canpok1/ai-feed/internal/domain"
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+)
+
+// opmlDocument はOPMLファイルのルート要素
+type opmlDocument struct {
+	XMLName xml.Name    `xml:"opml"`
+	Version string      `xml:"version,attr"`
+	Head    opmlHead    `xml:"head"`
+	Body    opmlOutline `xml:"body"`
+}
+
+// opmlHead はOPMLファイルのhead要素
+type opmlHead struct {
+	Title string `xml:"title,omitempty"`
+}
+
+// opmlOutline はOPMLファイルのoutline要素
+// xmlUrlを持つものはフィード、持たないものはフォルダとして扱う
+type opmlOutline struct {
+	Text     string        `xml:"text,attr,omitempty"`
+	Title    string        `xml:"title,attr,omitempty"`
+	Type     string        `xml:"type,attr,omitempty"`
+	XMLURL   string        `xml:"xmlUrl,attr,omitempty"`
+	HTMLURL  string        `xml:"htmlUrl,attr,omitempty"`
+	Category string        `xml:"category,attr,omitempty"`
+	Outlines []opmlOutline `xml:"outline"`
+}
+
+// label はoutlineの表示名を返す（title属性を優先し、なければtext属性）
+func (o *opmlOutline) label() string {
+	if title := strings.TrimSpace(o.Title); title != "" {
+		return title
+	}
+	return strings.TrimSpace(o.Text)
+}
+
+// isOPMLFile はファイルの拡張子と内容からOPML形式かどうかを判定する
+// Inoreaderなどは拡張子 .xml で書き出すため、.opml以外は先頭部分にopml要素があるかで判定する
+func isOPMLFile(filePath string, data []byte) bool {
+	if strings.EqualFold(filepath.Ext(filePath), ".opml") {
+		return true
+	}
+	head := data[:min(len(data), 1024)]
+	return bytes.Contains(bytes.ToLower(head), []byte("<opml"))
+}
+
+// ParseOPML はOPMLを読み込んでフィードの一覧を返す
+// 入れ子になったフォルダ（xmlUrlを持たないoutline）の名前と、category属性の値をフィードのタグとして扱う
+func ParseOPML(r io.Reader) ([]entity.Feed, error) {
+	var doc opmlDocument
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("failed to parse OPML: %w", err)
+	}
+
+	var feeds []entity.Feed
+	collectOPMLFeeds(doc.Body.Outlines, nil, &feeds)
+	return feeds, nil
+}
+
+// collectOPMLFeeds はoutlineを再帰的にたどり、フォルダ名をタグとしてフィードを集める
+func collectOPMLFeeds(outlines []opmlOutline, folders []string, feeds *[]entity.Feed) {
+	for i := range outlines {
+		outline := &outlines[i]
+		feedURL := strings.TrimSpace(outline.XMLURL)
+		if feedURL == "" {
+			// フォルダの場合は名前をタグとして子要素に引き継ぐ
+			childFolders := folders
+			if label := outline.label(); label != "" {
+				childFolders = append(slices.Clone(folders), label)
+			}
+			collectOPMLFeeds(outline.Outlines, childFolders, feeds)
+			continue
+		}
+
+		tags := slices.Clone(folders)
+		for _, category := range strings.Split(outline.Category, ",") {
+			// OPML 2.0のカテゴリは "/Tech/Go" のようにスラッシュ区切りで階層を表す
+			for _, tag := range strings.Split(category, "/") {
+				tag = strings.TrimSpace(tag)
+				if tag != "" && !slices.Contains(tags, tag) {
+					tags = append(tags, tag)
+				}
+			}
+		}
+
+		name := outline.label()
+		if name == feedURL {
+			name = ""
+		}
+		*feeds = append(*feeds, entity.Feed{
+			URL:  feedURL,
+			Name: name,
+			Tags: tags,
+		})
+	}
+}
+
+// OPMLFeedExporter はフィード一覧をOPML形式で書き出すFeedExporterの実装
+type OPMLFeedExporter struct {
+	title string
+}
+
+// NewOPMLFeedExporter はOPMLFeedExporterを作成する
+func NewOPMLFeedExporter(title string) *OPMLFeedExporter {
+	return &OPMLFeedExporter{title: title}
+}
+
+// Export はフィード一覧をOPML 2.0形式で書き出す
+// タグを持つフィードは最初のタグ名のフォルダに入れ、すべてのタグをcategory属性にも出力する
+func (e *OPMLFeedExporter) Export(w io.Writer, feeds []entity.Feed) error {
+	doc := opmlDocument{
+		Version: "2.0",
+		Head:    opmlHead{Title: e.title},
+	}
+
+	folderIndex := make(map[string]int)
+	for _, feed := range feeds {
+		label := feed.DisplayName()
+		outline := opmlOutline{
+			Text:     label,
+			Title:    label,
+			Type:     "rss",
+			XMLURL:   feed.URL,
+			Category: strings.Join(feed.Tags, ","),
+		}
+
+		if len(feed.Tags) == 0 {
+			doc.Body.Outlines = append(doc.Body.Outlines, outline)
+			continue
+		}
+
+		folder := feed.Tags[0]
+		index, ok := folderIndex[folder]
+		if !ok {
+			index = len(doc.Body.Outlines)
+			folderIndex[folder] = index
+			doc.Body.Outlines = append(doc.Body.Outlines, opmlOutline{Text: folder, Title: folder})
+		}
+		doc.Body.Outlines[index].Outlines = append(doc.Body.Outlines[index].Outlines, outline)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return fmt.Errorf("failed to write OPML: %w", err)
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(doc); err != nil {
+		return fmt.Errorf("failed to write OPML: %w", err)
+	}
+	if _, err := io.WriteString(w, "\n"); err != nil {
+		return fmt.Errorf("failed to write OPML: %w", err)
+	}
+	return nil
+}
+
+// OPMLFeedExporterがFeedExporterインターフェースを実装していることを確認する
+var _ domain.FeedExporter = (*OPMLFeedExporter)(nil)
@@ -0,0 +1,126 @@
+package infra
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testOPML = `<?xml version="1.0" encoding="UTF-8"?>
+<opml version="1.0">
+  <head><title>Subscriptions</title></head>
+  <body>
+    <outline text="Top Level" title="Top Level" type="rss" xmlUrl="https://example.com/top.xml" htmlUrl="https://example.com/"/>
+    <outline text="Tech" title="Tech">
+      <outline text="Go Blog" type="rss" xmlUrl="https://go.dev/blog/feed.atom"/>
+      <outline text="Backend">
+        <outline title="Backend News" text="ignored" type="rss" xmlUrl="https://example.com/backend.xml" category="/news/daily,tech"/>
+      </outline>
+    </outline>
+    <outline text="Empty Folder"/>
+    <outline text="https://example.com/no-title.xml" type="rss" xmlUrl="https://example.com/no-title.xml"/>
+  </body>
+</opml>`
+
+func TestParseOPML(t *testing.T) {
+	t.Run("入れ子のフォルダとcategoryをタグとして読み込む", func(t *testing.T) {
+		feeds, err := ParseOPML(strings.NewReader(testOPML))
+
+		require.NoError(t, err)
+		assert.Equal(t, []entity.Feed{
+			{URL: "https://example.com/top.xml", Name: "Top Level", Tags: nil},
+			{URL: "https://go.dev/blog/feed.atom", Name: "Go Blog", Tags: []string{"Tech"}},
+			{URL: "https://example.com/backend.xml", Name: "Backend News", Tags: []string{"Tech", "Backend", "news", "daily", "tech"}},
+			{URL: "https://example.com/no-title.xml", Name: "", Tags: nil},
+		}, feeds)
+	})
+
+	t.Run("不正なXML", func(t *testing.T) {
+		_, err := ParseOPML(strings.NewReader("<opml><body><outline"))
+		assert.ErrorContains(t, err, "failed to parse OPML")
+	})
+}
+
+func TestOPMLFeedExporter_Export(t *testing.T) {
+	feeds := []entity.Feed{
+		{URL: "https://example.com/a.xml", Name: "A", Tags: []string{"tech", "go"}},
+		{URL: "https://example.com/b.xml"},
+		{URL: "https://example.com/c.xml", Name: "C & Co", Tags: []string{"tech"}},
+	}
+
+	var buf bytes.Buffer
+	err := NewOPMLFeedExporter("ai-feed").Export(&buf, feeds)
+	require.NoError(t, err)
+
+	output := buf.String()
+	assert.True(t, strings.HasPrefix(output, `<?xml version="1.0" encoding="UTF-8"?>`))
+	assert.Contains(t, output, `<opml version="2.0">`)
+	assert.Contains(t, output, `<title>ai-feed</title>`)
+	assert.Contains(t, output, `text="C &amp; Co"`)
+	// URLのみのフィードは表示名としてURLを出力する
+	assert.Contains(t, output, `text="https://example.com/b.xml"`)
+
+	t.Run("書き出したOPMLを読み込むと同じフィードに戻る", func(t *testing.T) {
+		imported, err := ParseOPML(strings.NewReader(output))
+		require.NoError(t, err)
+
+		assert.ElementsMatch(t, []entity.Feed{
+			{URL: "https://example.com/a.xml", Name: "A", Tags: []string{"tech", "go"}},
+			{URL: "https://example.com/b.xml", Tags: nil},
+			{URL: "https://example.com/c.xml", Name: "C & Co", Tags: []string{"tech"}},
+		}, imported)
+	})
+}
+
+func TestReadFeedsFromFile_OPML(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	tests := []struct {
+		name     string
+		fileName string
+	}{
+		{name: "拡張子.opml", fileName: "subscriptions.opml"},
+		{name: "拡張子.xmlでも内容から判定する", fileName: "Inoreader Feeds.xml"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(tmpDir, tt.fileName)
+			require.NoError(t, os.WriteFile(path, []byte(testOPML), 0644))
+
+			feeds, err := ReadFeedsFromFile(path)
+
+			require.NoError(t, err)
+			require.Len(t, feeds, 4)
+			assert.Equal(t, "https://go.dev/blog/feed.atom", feeds[1].URL)
+			assert.Equal(t, []string{"Tech"}, feeds[1].Tags)
+			assert.True(t, feeds[1].IsEnabled())
+		})
+	}
+
+	t.Run("テキスト形式は従来どおり1行1URL", func(t *testing.T) {
+		path := filepath.Join(tmpDir, "feeds.txt")
+		require.NoError(t, os.WriteFile(path, []byte("https://example.com/a.xml\n"), 0644))
+
+		feeds, err := ReadFeedsFromFile(path)
+
+		require.NoError(t, err)
+		assert.Equal(t, entity.NewFeedsFromURLs([]string{"https://example.com/a.xml"}), feeds)
+	})
+
+	t.Run("不正なOPML", func(t *testing.T) {
+		path := filepath.Join(tmpDir, "broken.opml")
+		require.NoError(t, os.WriteFile(path, []byte("<opml><body>"), 0644))
+
+		_, err := ReadFeedsFromFile(path)
+
+		assert.ErrorContains(t, err, "failed to read OPML")
+	})
+
+}
@@ -0,0 +1,21 @@
+package selector
+
+import (
+	"context"
+
+	"github.com/canpok1/ai-feed/internal/domain"
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"github.com/canpok1/ai-feed/internal/infra/llm"
+)
+
+// chainArticleSelector はAIの呼び出し順（chain）のプロバイダで順に記事を選択し、最初に成功した結果を返す
+type chainArticleSelector struct {
+	selectors []llm.ChainEntry[domain.ArticleSelector]
+}
+
+// Select は呼び出し順に記事を選択する（すべて失敗した場合は最後のエラーを返す）
+func (s *chainArticleSelector) Select(ctx context.Context, articles []entity.Article) (*entity.Article, error) {
+	return llm.CallChain(ctx, "article selection", s.selectors, func(selector domain.ArticleSelector) (*entity.Article, error) {
+		return selector.Select(ctx, articles)
+	})
+}
@@ -2,17 +2,22 @@ package selector
 
 import (
 	"fmt"
+	"math/rand/v2"
 
 	"github.com/canpok1/ai-feed/internal/domain"
 	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"github.com/canpok1/ai-feed/internal/infra/llm"
 )
 
 // ArticleSelectorFactory は ArticleSelector を生成するファクトリ
-type ArticleSelectorFactory struct{}
+type ArticleSelectorFactory struct {
+	rng *rand.Rand
+}
 
 // NewArticleSelectorFactory は新しいファクトリを作成する
-func NewArticleSelectorFactory() *ArticleSelectorFactory {
-	return &ArticleSelectorFactory{}
+// rngはモックのrandomモードでの記事選択に使う（nilの場合はグローバルな乱数生成器）
+func NewArticleSelectorFactory(rng *rand.Rand) *ArticleSelectorFactory {
+	return &ArticleSelectorFactory{rng: rng}
 }
 
 // MakeArticleSelector は設定に基づいて適切な ArticleSelector を生成する
@@ -29,13 +34,59 @@ func (f *ArticleSelectorFactory) MakeArticleSelector(
 
 	// Mock設定が有効な場合はモック実装を返す
 	if aiConfig.Mock != nil && aiConfig.Mock.Enabled != nil && *aiConfig.Mock.Enabled {
-		return newMockArticleSelector(aiConfig.Mock.SelectorMode)
+		return newMockArticleSelector(aiConfig.Mock.SelectorMode, f.rng)
 	}
 
-	// Gemini設定がある場合はGemini実装を返す
-	if aiConfig.Gemini != nil {
-		return newGeminiArticleSelector(aiConfig, promptConfig)
+	// 呼び出し順が設定されている場合は、失敗したときに次のプロバイダで記事を選択する
+	if len(aiConfig.Chain) > 0 {
+		return f.makeChainArticleSelector(aiConfig, promptConfig)
 	}
 
-	return nil, fmt.Errorf("no supported AI configuration found")
+	// それ以外はAI設定のプロバイダのLLMクライアントで記事を選択する
+	client, err := llm.NewClient(aiConfig)
+	if err != nil {
+		return nil, err
+	}
+	return newLLMArticleSelector(client, promptConfig), nil
+}
+
+// makeChainArticleSelector は呼び出し順のプロバイダの記事選択器を順に試すArticleSelectorを生成する
+// 設定がない、または不正なプロバイダは飛ばす
+func (f *ArticleSelectorFactory) makeChainArticleSelector(
+	aiConfig *entity.AIConfig,
+	promptConfig *entity.PromptConfig,
+) (domain.ArticleSelector, error) {
+	selectors, err := llm.BuildChain("article selection", aiConfig.Chain, func(provider string) (domain.ArticleSelector, error) {
+		return f.makeProviderArticleSelector(provider, aiConfig, promptConfig)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &chainArticleSelector{selectors: selectors}, nil
+}
+
+// makeProviderArticleSelector は指定したプロバイダの記事選択器を生成する
+func (f *ArticleSelectorFactory) makeProviderArticleSelector(
+	name string,
+	aiConfig *entity.AIConfig,
+	promptConfig *entity.PromptConfig,
+) (domain.ArticleSelector, error) {
+	// mockは設定がなくても使えるように、記事選択モードの省略時は先頭の記事を選ぶ
+	if name == "mock" {
+		mode := "first"
+		if aiConfig.Mock != nil && aiConfig.Mock.SelectorMode != "" {
+			mode = aiConfig.Mock.SelectorMode
+		}
+		return newMockArticleSelector(mode, f.rng)
+	}
+
+	provider, ok := llm.Lookup(name)
+	if !ok {
+		return nil, fmt.Errorf("unsupported AI provider: %s", name)
+	}
+	client, err := provider.NewClient(aiConfig)
+	if err != nil {
+		return nil, err
+	}
+	return newLLMArticleSelector(client, promptConfig), nil
 }
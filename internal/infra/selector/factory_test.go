@@ -0,0 +1,78 @@
+package selector
+
+import (
+	"context"
+	"testing"
+
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArticleSelectorFactory_MakeArticleSelector_Chain(t *testing.T) {
+	articles := []entity.Article{
+		{Title: "Article 1", Link: "https://example.com/1"},
+		{Title: "Article 2", Link: "https://example.com/2"},
+	}
+
+	tests := []struct {
+		name           string
+		config         *entity.AIConfig
+		wantProviders  []string
+		wantTitle      string
+		wantErrContain string
+	}{
+		{
+			name: "設定がない、または不正なプロバイダは飛ばす",
+			config: &entity.AIConfig{
+				Gemini: &entity.GeminiConfig{Type: "gemini-2.5-flash"},
+				Chain:  []string{"gemini", "openai", "mock"},
+			},
+			wantProviders: []string{"mock"},
+			wantTitle:     "Article 1",
+		},
+		{
+			name: "mockの記事選択モードを使う",
+			config: &entity.AIConfig{
+				Ollama: &entity.OllamaConfig{Model: "llama3.1"},
+				Mock:   &entity.MockConfig{SelectorMode: "last"},
+				Chain:  []string{"ollama", "mock"},
+			},
+			wantProviders: []string{"ollama", "mock"},
+			wantTitle:     "Article 2",
+		},
+		{
+			name: "使用できるプロバイダがない場合はエラー",
+			config: &entity.AIConfig{
+				Chain: []string{"gemini", "openai"},
+			},
+			wantErrContain: "no usable AI provider in chain: gemini, openai",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			factory := NewArticleSelectorFactory(nil)
+			selector, err := factory.MakeArticleSelector(tt.config, &entity.PromptConfig{})
+			if tt.wantErrContain != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErrContain)
+				return
+			}
+			require.NoError(t, err)
+
+			chain, ok := selector.(*chainArticleSelector)
+			require.True(t, ok)
+			var providers []string
+			for _, entry := range chain.selectors {
+				providers = append(providers, entry.Provider)
+			}
+			assert.Equal(t, tt.wantProviders, providers)
+
+			// 末尾のmockで選択した結果を確認する
+			article, err := chain.selectors[len(chain.selectors)-1].Value.Select(context.Background(), articles)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTitle, article.Title)
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package selector
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/canpok1/ai-feed/internal/domain"
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"github.com/canpok1/ai-feed/internal/infra/content"
+)
+
+// selectionInstruction は応答の形式を指示する文（スキーマを指定できないプロバイダにも形式を伝えるため、常にプロンプトに含める）
+const selectionInstruction = `選択した記事のインデックス（0始まり）を {"selected_index": 0} の形式のJSONで回答してください。`
+
+// llmArticleSelector はdomain.LLMClientを使用した記事選択の実装
+type llmArticleSelector struct {
+	client       domain.LLMClient
+	systemPrompt string
+	prompt       string
+	budget       entity.ContentBudget
+}
+
+// newLLMArticleSelector は新しいllmArticleSelectorを作成する
+func newLLMArticleSelector(client domain.LLMClient, promptConfig *entity.PromptConfig) domain.ArticleSelector {
+	return &llmArticleSelector{
+		client:       client,
+		systemPrompt: promptConfig.SystemPrompt,
+		prompt:       promptConfig.SelectorPrompt,
+		budget:       promptConfig.SelectorContentBudgetOrDefault(),
+	}
+}
+
+func (s *llmArticleSelector) Select(ctx context.Context, articles []entity.Article) (*entity.Article, error) {
+	if len(articles) == 0 {
+		return nil, fmt.Errorf("no articles provided")
+	}
+
+	text, err := s.client.GenerateJSON(ctx, domain.LLMRequest{
+		SystemPrompt: s.systemPrompt,
+		Prompt:       s.buildSelectionPrompt(articles) + selectionInstruction,
+	}, selectionSchema(len(articles)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate content: %w", err)
+	}
+
+	// レスポンスパース
+	var result struct {
+		SelectedIndex *int `json:"selected_index"`
+	}
+	if err := json.Unmarshal([]byte(text), &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	if result.SelectedIndex == nil {
+		return nil, fmt.Errorf("failed to parse response: selected_index is missing: %s", text)
+	}
+
+	// バリデーション
+	index := *result.SelectedIndex
+	if index < 0 || index >= len(articles) {
+		return nil, fmt.Errorf("invalid index: %d (total articles: %d)", index, len(articles))
+	}
+
+	return &articles[index], nil
+}
+
+// buildSelectionPrompt は記事選択用のプロンプトを生成する
+func (s *llmArticleSelector) buildSelectionPrompt(articles []entity.Article) string {
+	var sb strings.Builder
+
+	// プロンプトが設定されていればそれを使用
+	if s.prompt != "" {
+		sb.WriteString(s.prompt)
+		sb.WriteString("\n\n")
+	}
+
+	// 記事リストを追加（本文はプレーンテキストに変換して上限までに切り詰める）
+	for i, article := range articles {
+		sb.WriteString(fmt.Sprintf("[%d] タイトル: %s\n", i, article.Title))
+		sb.WriteString(fmt.Sprintf("URL: %s\n", article.Link))
+		sb.WriteString(fmt.Sprintf("内容: %s\n\n", content.Prepare(article.Content, s.budget)))
+	}
+
+	return sb.String()
+}
+
+// selectionSchema は記事選択の応答のJSONスキーマを返す（インデックスの範囲も制約する）
+func selectionSchema(articleCount int) *domain.JSONSchema {
+	minimum, maximum := 0.0, float64(articleCount-1)
+	return &domain.JSONSchema{
+		Type: "object",
+		Properties: map[string]*domain.JSONSchema{
+			"selected_index": {
+				Type:        "integer",
+				Description: "選択した記事のインデックス（0始まり）",
+				Minimum:     &minimum,
+				Maximum:     &maximum,
+			},
+		},
+		Required: []string{"selected_index"},
+	}
+}
@@ -0,0 +1,108 @@
+package selector
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/canpok1/ai-feed/internal/domain"
+	"github.com/canpok1/ai-feed/internal/domain/entity"
+	"github.com/canpok1/ai-feed/internal/domain/mock_domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestLLMArticleSelector_buildSelectionPrompt(t *testing.T) {
+	selector := &llmArticleSelector{
+		prompt: "最も興味深い記事を選んでください",
+		budget: entity.ContentBudget{MaxChars: 10},
+	}
+	articles := []entity.Article{
+		{
+			Title:   "記事1",
+			Link:    "https://example.com/1",
+			Content: "<p>Go 1.25 &amp; generics</p><script>track()</script>",
+		},
+		{
+			Title:   "記事2",
+			Link:    "https://example.com/2",
+			Content: "短い本文",
+		},
+	}
+
+	prompt := selector.buildSelectionPrompt(articles)
+
+	assert.Equal(t, "最も興味深い記事を選んでください\n\n"+
+		"[0] タイトル: 記事1\nURL: https://example.com/1\n内容: Go 1.25 &…\n\n"+
+		"[1] タイトル: 記事2\nURL: https://example.com/2\n内容: 短い本文\n\n", prompt)
+}
+
+func TestLLMArticleSelector_Select(t *testing.T) {
+	articles := []entity.Article{
+		{Title: "Article 1", Link: "https://example.com/1"},
+		{Title: "Article 2", Link: "https://example.com/2"},
+	}
+
+	tests := []struct {
+		name           string
+		response       string
+		err            error
+		wantTitle      string
+		wantErrContain string
+	}{
+		{
+			name:      "JSONの応答から記事を選ぶ",
+			response:  `{"selected_index": 1}`,
+			wantTitle: "Article 2",
+		},
+		{
+			name:           "範囲外のインデックスはエラー",
+			response:       `{"selected_index": 5}`,
+			wantErrContain: "invalid index: 5",
+		},
+		{
+			name:           "インデックスがない応答はエラー",
+			response:       `{"index": 0}`,
+			wantErrContain: "selected_index is missing",
+		},
+		{
+			name:           "JSONでない応答はエラー",
+			response:       "2番目の記事がおすすめです",
+			wantErrContain: "failed to parse response",
+		},
+		{
+			name:           "LLMのエラーを返す",
+			err:            errors.New("quota exceeded"),
+			wantErrContain: "failed to generate content: quota exceeded",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			client := mock_domain.NewMockLLMClient(ctrl)
+			client.EXPECT().GenerateJSON(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, req domain.LLMRequest, schema *domain.JSONSchema) (string, error) {
+					assert.Equal(t, "system", req.SystemPrompt)
+					assert.Contains(t, req.Prompt, "[1] タイトル: Article 2")
+					assert.Contains(t, req.Prompt, selectionInstruction)
+					// 選択できるインデックスの範囲をスキーマで伝える
+					assert.Equal(t, []string{"selected_index"}, schema.Required)
+					assert.Equal(t, 1.0, *schema.Properties["selected_index"].Maximum)
+					return tt.response, tt.err
+				})
+
+			selector := newLLMArticleSelector(client, &entity.PromptConfig{SystemPrompt: "system", SelectorPrompt: "選んでください"})
+
+			article, err := selector.Select(context.Background(), articles)
+			if tt.wantErrContain != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErrContain)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTitle, article.Title)
+		})
+	}
+}
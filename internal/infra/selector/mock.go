@@ -3,22 +3,23 @@ package selector
 import (
 	"context"
 	"fmt"
-	"math/rand"
+	"math/rand/v2"
 
 	"github.com/canpok1/ai-feed/internal/domain/entity"
 )
 
 // mockArticleSelector はテスト用のモック記事選択器
 type mockArticleSelector struct {
-	mode string // "first", "random", "last"
+	mode string     // "first", "random", "last"
+	rng  *rand.Rand // randomモードで使う乱数生成器（nilの場合はグローバルな乱数生成器）
 }
 
 // newMockArticleSelector は新しいモック記事選択器を作成する
-func newMockArticleSelector(mode string) (*mockArticleSelector, error) {
+func newMockArticleSelector(mode string, rng *rand.Rand) (*mockArticleSelector, error) {
 	if !entity.IsValidMockSelectorMode(mode) {
 		return nil, fmt.Errorf("invalid selector mode: %s (must be first, random, or last)", mode)
 	}
-	return &mockArticleSelector{mode: mode}, nil
+	return &mockArticleSelector{mode: mode, rng: rng}, nil
 }
 
 // Select は設定されたモードに基づいて記事を選択する
@@ -34,7 +35,11 @@ func (s *mockArticleSelector) Select(_ context.Context, articles []entity.Articl
 	case "last":
 		index = len(articles) - 1
 	case "random":
-		index = rand.Intn(len(articles))
+		if s.rng != nil {
+			index = s.rng.IntN(len(articles))
+		} else {
+			index = rand.IntN(len(articles))
+		}
 	default:
 		index = 0
 	}
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/canpok1/ai-feed/internal/domain"
 	"github.com/canpok1/ai-feed/internal/domain/entity"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -45,7 +46,7 @@ func TestNewMockArticleSelector(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			selector, err := newMockArticleSelector(tt.mode)
+			selector, err := newMockArticleSelector(tt.mode, nil)
 			if tt.expectError {
 				assert.Error(t, err)
 				assert.Nil(t, selector)
@@ -67,7 +68,7 @@ func TestMockArticleSelector_Select(t *testing.T) {
 	}
 
 	t.Run("firstモードは最初の記事を返す", func(t *testing.T) {
-		selector, err := newMockArticleSelector("first")
+		selector, err := newMockArticleSelector("first", nil)
 		require.NoError(t, err)
 
 		article, err := selector.Select(context.Background(), articles)
@@ -76,7 +77,7 @@ func TestMockArticleSelector_Select(t *testing.T) {
 	})
 
 	t.Run("lastモードは最後の記事を返す", func(t *testing.T) {
-		selector, err := newMockArticleSelector("last")
+		selector, err := newMockArticleSelector("last", nil)
 		require.NoError(t, err)
 
 		article, err := selector.Select(context.Background(), articles)
@@ -85,7 +86,7 @@ func TestMockArticleSelector_Select(t *testing.T) {
 	})
 
 	t.Run("randomモードは記事を返す", func(t *testing.T) {
-		selector, err := newMockArticleSelector("random")
+		selector, err := newMockArticleSelector("random", nil)
 		require.NoError(t, err)
 
 		article, err := selector.Select(context.Background(), articles)
@@ -95,8 +96,23 @@ func TestMockArticleSelector_Select(t *testing.T) {
 		assert.Contains(t, []string{"Article 1", "Article 2", "Article 3"}, article.Title)
 	})
 
+	t.Run("randomモードは同じシードなら同じ順に記事を選ぶ", func(t *testing.T) {
+		pick := func() []string {
+			selector, err := newMockArticleSelector("random", domain.NewRandom(42))
+			require.NoError(t, err)
+			var titles []string
+			for range 10 {
+				article, err := selector.Select(context.Background(), articles)
+				require.NoError(t, err)
+				titles = append(titles, article.Title)
+			}
+			return titles
+		}
+		assert.Equal(t, pick(), pick())
+	})
+
 	t.Run("空の記事リストはエラーを返す", func(t *testing.T) {
-		selector, err := newMockArticleSelector("first")
+		selector, err := newMockArticleSelector("first", nil)
 		require.NoError(t, err)
 
 		article, err := selector.Select(context.Background(), []entity.Article{})
@@ -108,7 +124,7 @@ func TestMockArticleSelector_Select(t *testing.T) {
 	t.Run("単一記事のリスト", func(t *testing.T) {
 		singleArticle := []entity.Article{articles[0]}
 
-		selector, err := newMockArticleSelector("last")
+		selector, err := newMockArticleSelector("last", nil)
 		require.NoError(t, err)
 
 		article, err := selector.Select(context.Background(), singleArticle)
@@ -1,6 +1,7 @@
 package infra
 
 import (
+	"fmt"
 	"html/template"
 	"strings"
 
@@ -25,10 +26,11 @@ func (f *ConfigValidatorFactory) Create(config *domain.LoadedConfig, profile *en
 
 	if config.Cache != nil {
 		infraConfig.Cache = &CacheConfig{
-			Enabled:       config.Cache.Enabled,
-			FilePath:      config.Cache.FilePath,
-			MaxEntries:    config.Cache.MaxEntries,
-			RetentionDays: config.Cache.RetentionDays,
+			Enabled:           config.Cache.Enabled,
+			FilePath:          config.Cache.FilePath,
+			MaxEntries:        config.Cache.MaxEntries,
+			RetentionDays:     config.Cache.RetentionDays,
+			FeedStateFilePath: config.Cache.FeedStateFilePath,
 		}
 	}
 
@@ -85,6 +87,33 @@ func (v *ConfigValidator) Validate() (*domain.ValidationResult, error) {
 	// キャッシュ設定のバリデーション（設定されている場合のみ）
 	v.validateCache(result)
 
+	// フィード取得設定のバリデーション（設定されている場合のみ）
+	v.validateFetch(result)
+
+	// フィード選択設定のバリデーション（設定されている場合のみ）
+	v.validateFeedSelection(result)
+
+	// フィード健全性設定のバリデーション（設定されている場合のみ）
+	v.validateFeedHealth(result)
+
+	// 記事フィルタ設定のバリデーション（設定されている場合のみ）
+	v.validateFilters(result)
+
+	// 記事本文の取得設定のバリデーション（設定されている場合のみ）
+	v.validateFullText(result)
+
+	// URL正規化設定のバリデーション（設定されている場合のみ）
+	v.validateCanonicalURL(result)
+
+	// 重複記事のまとめ方の設定のバリデーション（設定されている場合のみ）
+	v.validateDedup(result)
+
+	// 同じ話題の記事の抑制設定のバリデーション（設定されている場合のみ）
+	v.validateTopicSuppression(result)
+
+	// フィード定義のバリデーション（設定されている場合のみ）
+	v.validateFeeds(result)
+
 	// エラーがある場合はValidをfalseに設定
 	if len(result.Errors) > 0 {
 		result.Valid = false
@@ -104,6 +133,41 @@ func (v *ConfigValidator) validateAI(result *domain.ValidationResult) {
 		return
 	}
 
+	if v.profile.AI.Retry != nil {
+		for _, errMsg := range v.profile.AI.Retry.Validate().Errors {
+			result.Errors = append(result.Errors, domain.ValidationError{
+				Field:   "ai.retry",
+				Type:    domain.ValidationErrorTypeInvalid,
+				Message: errMsg,
+			})
+		}
+	}
+
+	// 呼び出し順が設定されている場合は、使用できないプロバイダを実行時に飛ばすため個別の設定は検証しない
+	if len(v.profile.AI.Chain) > 0 {
+		for _, errMsg := range v.profile.AI.ValidateChain().Errors {
+			result.Errors = append(result.Errors, domain.ValidationError{
+				Field:   "ai.chain",
+				Type:    domain.ValidationErrorTypeInvalid,
+				Message: errMsg,
+			})
+		}
+		result.Summary.AIChain = v.profile.AI.Chain
+		return
+	}
+
+	// OpenAI互換APIの設定がある場合はGemini設定は不要
+	if v.profile.AI.OpenAI != nil {
+		v.validateOpenAI(v.profile.AI.OpenAI, result)
+		return
+	}
+
+	// Ollamaの設定がある場合はGemini設定は不要
+	if v.profile.AI.Ollama != nil {
+		v.validateOllama(v.profile.AI.Ollama, result)
+		return
+	}
+
 	if v.profile.AI.Gemini == nil {
 		result.Errors = append(result.Errors, domain.ValidationError{
 			Field:   "ai.gemini",
@@ -146,6 +210,51 @@ func (v *ConfigValidator) validateAI(result *domain.ValidationResult) {
 	}
 }
 
+// validateOpenAI はOpenAI互換APIの設定をバリデーションする
+func (v *ConfigValidator) validateOpenAI(openAI *entity.OpenAIConfig, result *domain.ValidationResult) {
+	for _, errMsg := range openAI.Validate().Errors {
+		result.Errors = append(result.Errors, domain.ValidationError{
+			Field:   "ai.openai",
+			Type:    domain.ValidationErrorTypeInvalid,
+			Message: errMsg,
+		})
+	}
+
+	// APIキーは認証が不要なローカルのサーバーでは省略できるため、ダミー値のみ確認する
+	if !openAI.APIKey.IsEmpty() && isDummyValue(openAI.APIKey.Value()) {
+		result.Errors = append(result.Errors, domain.ValidationError{
+			Field:   "ai.openai.api_key",
+			Type:    domain.ValidationErrorTypeDummyValue,
+			Message: "OpenAI APIキーがダミー値です: \"" + openAI.APIKey.Value() + "\"",
+		})
+	}
+
+	// サマリーの更新
+	if openAI.Model != "" {
+		result.Summary.OpenAIConfigured = true
+		result.Summary.OpenAIModel = openAI.Model
+		result.Summary.OpenAIBaseURL = openAI.BaseURLOrDefault()
+	}
+}
+
+// validateOllama はOllamaの設定をバリデーションする
+func (v *ConfigValidator) validateOllama(ollama *entity.OllamaConfig, result *domain.ValidationResult) {
+	for _, errMsg := range ollama.Validate().Errors {
+		result.Errors = append(result.Errors, domain.ValidationError{
+			Field:   "ai.ollama",
+			Type:    domain.ValidationErrorTypeInvalid,
+			Message: errMsg,
+		})
+	}
+
+	// サマリーの更新
+	if ollama.Model != "" {
+		result.Summary.OllamaConfigured = true
+		result.Summary.OllamaModel = ollama.Model
+		result.Summary.OllamaBaseURL = ollama.BaseURLOrDefault()
+	}
+}
+
 // validatePrompt はプロンプト設定をバリデーションする
 func (v *ConfigValidator) validatePrompt(result *domain.ValidationResult) {
 	if v.profile.Prompt == nil {
@@ -177,6 +286,25 @@ func (v *ConfigValidator) validatePrompt(result *domain.ValidationResult) {
 		})
 	}
 
+	// ContentBudget のバリデーション
+	budgets := []struct {
+		field  string
+		target string
+		budget entity.ContentBudget
+	}{
+		{field: "prompt.content_budget.selector", target: "記事選択プロンプト", budget: prompt.SelectorContentBudget},
+		{field: "prompt.content_budget.comment", target: "コメントプロンプト", budget: prompt.CommentContentBudget},
+	}
+	for _, b := range budgets {
+		for _, errMsg := range b.budget.Validate(b.target).Errors {
+			result.Errors = append(result.Errors, domain.ValidationError{
+				Field:   b.field,
+				Type:    domain.ValidationErrorTypeInvalid,
+				Message: errMsg,
+			})
+		}
+	}
+
 	// サマリーの更新
 	if prompt.SystemPrompt != "" {
 		result.Summary.SystemPromptConfigured = true
@@ -250,6 +378,139 @@ func (v *ConfigValidator) validateCache(result *domain.ValidationResult) {
 	}
 }
 
+// validateFetch はフィード取得設定をバリデーションする
+func (v *ConfigValidator) validateFetch(result *domain.ValidationResult) {
+	if v.profile.Fetch == nil {
+		return
+	}
+
+	for _, errMsg := range v.profile.Fetch.Validate().Errors {
+		result.Errors = append(result.Errors, domain.ValidationError{
+			Field:   "fetch",
+			Type:    domain.ValidationErrorTypeInvalid,
+			Message: errMsg,
+		})
+	}
+}
+
+// validateFeedSelection はフィード選択設定をバリデーションする
+func (v *ConfigValidator) validateFeedSelection(result *domain.ValidationResult) {
+	if v.profile.FeedSelection == nil {
+		return
+	}
+
+	for _, errMsg := range v.profile.FeedSelection.Validate().Errors {
+		result.Errors = append(result.Errors, domain.ValidationError{
+			Field:   "feed_selection.strategy",
+			Type:    domain.ValidationErrorTypeInvalid,
+			Message: errMsg,
+		})
+	}
+}
+
+// validateFeedHealth はフィード健全性設定をバリデーションする
+func (v *ConfigValidator) validateFeedHealth(result *domain.ValidationResult) {
+	if v.profile.FeedHealth == nil {
+		return
+	}
+
+	for _, errMsg := range v.profile.FeedHealth.Validate().Errors {
+		result.Errors = append(result.Errors, domain.ValidationError{
+			Field:   "feed_health",
+			Type:    domain.ValidationErrorTypeInvalid,
+			Message: errMsg,
+		})
+	}
+}
+
+// validateFilters は記事フィルタ設定をバリデーションする
+func (v *ConfigValidator) validateFilters(result *domain.ValidationResult) {
+	if v.profile.Filters == nil {
+		return
+	}
+
+	for _, errMsg := range v.profile.Filters.Validate().Errors {
+		result.Errors = append(result.Errors, domain.ValidationError{
+			Field:   "filters",
+			Type:    domain.ValidationErrorTypeInvalid,
+			Message: errMsg,
+		})
+	}
+}
+
+// validateFullText は記事本文の取得設定をバリデーションする
+func (v *ConfigValidator) validateFullText(result *domain.ValidationResult) {
+	if v.profile.FullText == nil {
+		return
+	}
+
+	for _, errMsg := range v.profile.FullText.Validate().Errors {
+		result.Errors = append(result.Errors, domain.ValidationError{
+			Field:   "full_text",
+			Type:    domain.ValidationErrorTypeInvalid,
+			Message: errMsg,
+		})
+	}
+}
+
+// validateCanonicalURL は記事URLの正規化設定をバリデーションする
+func (v *ConfigValidator) validateCanonicalURL(result *domain.ValidationResult) {
+	if v.profile.CanonicalURL == nil {
+		return
+	}
+
+	for _, errMsg := range v.profile.CanonicalURL.Validate().Errors {
+		result.Errors = append(result.Errors, domain.ValidationError{
+			Field:   "canonical_url.strip_params",
+			Type:    domain.ValidationErrorTypeInvalid,
+			Message: errMsg,
+		})
+	}
+}
+
+// validateDedup は重複記事のまとめ方の設定をバリデーションする
+func (v *ConfigValidator) validateDedup(result *domain.ValidationResult) {
+	if v.profile.Dedup == nil {
+		return
+	}
+
+	for _, errMsg := range v.profile.Dedup.Validate().Errors {
+		result.Errors = append(result.Errors, domain.ValidationError{
+			Field:   "dedup",
+			Type:    domain.ValidationErrorTypeInvalid,
+			Message: errMsg,
+		})
+	}
+}
+
+// validateTopicSuppression は同じ話題の記事の抑制設定をバリデーションする
+func (v *ConfigValidator) validateTopicSuppression(result *domain.ValidationResult) {
+	if v.profile.TopicSuppression == nil {
+		return
+	}
+
+	for _, errMsg := range v.profile.TopicSuppression.Validate().Errors {
+		result.Errors = append(result.Errors, domain.ValidationError{
+			Field:   "topic_suppression",
+			Type:    domain.ValidationErrorTypeInvalid,
+			Message: errMsg,
+		})
+	}
+}
+
+// validateFeeds はプロファイルのフィード定義をバリデーションする
+func (v *ConfigValidator) validateFeeds(result *domain.ValidationResult) {
+	for i, feed := range v.profile.Feeds {
+		for _, errMsg := range feed.Validate().Errors {
+			result.Errors = append(result.Errors, domain.ValidationError{
+				Field:   fmt.Sprintf("feeds[%d]", i),
+				Type:    domain.ValidationErrorTypeInvalid,
+				Message: errMsg,
+			})
+		}
+	}
+}
+
 // validateSlackAPI はSlack API設定をバリデーションする
 func (v *ConfigValidator) validateSlackAPI(slack *entity.SlackAPIConfig, result *domain.ValidationResult) {
 	if slack.APIToken.IsEmpty() {
@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/canpok1/ai-feed/internal/domain"
 	"github.com/canpok1/ai-feed/internal/domain/entity"
@@ -228,6 +229,32 @@ func TestConfigValidator_Validate_Success(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Ollamaが設定されている場合はGemini設定は不要",
+			config: &infra.Config{
+				DefaultProfile: &infra.Profile{},
+			},
+			profile: &entity.Profile{
+				AI: &entity.AIConfig{
+					Ollama: &entity.OllamaConfig{Model: "llama3.1"},
+				},
+				Prompt: &entity.PromptConfig{
+					SystemPrompt:          "test system prompt",
+					CommentPromptTemplate: "test prompt template",
+				},
+			},
+			want: &domain.ValidationResult{
+				Valid:  true,
+				Errors: []domain.ValidationError{},
+				Summary: domain.ConfigSummary{
+					OllamaConfigured:        true,
+					OllamaModel:             "llama3.1",
+					OllamaBaseURL:           "http://localhost:11434",
+					SystemPromptConfigured:  true,
+					CommentPromptConfigured: true,
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -296,6 +323,105 @@ func TestConfigValidator_Validate_Errors(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "OpenAI互換APIのモデル名が未設定",
+			config: &infra.Config{
+				DefaultProfile: &infra.Profile{},
+			},
+			profile: &entity.Profile{
+				AI: &entity.AIConfig{
+					OpenAI: &entity.OpenAIConfig{BaseURL: "localhost:11434"},
+				},
+				Prompt: &entity.PromptConfig{
+					SystemPrompt:          "test system prompt",
+					CommentPromptTemplate: "test prompt template",
+				},
+			},
+			expectValid: false,
+			expectError: []domain.ValidationError{
+				{
+					Field:   "ai.openai",
+					Type:    domain.ValidationErrorTypeInvalid,
+					Message: "OpenAI設定のモデル名が設定されていません",
+				},
+				{
+					Field:   "ai.openai",
+					Type:    domain.ValidationErrorTypeInvalid,
+					Message: "OpenAI設定のベースURLにはhttp://またはhttps://で始まるURLを指定してください: localhost:11434",
+				},
+			},
+		},
+		{
+			name: "Ollamaのkeep_aliveが不正",
+			config: &infra.Config{
+				DefaultProfile: &infra.Profile{},
+			},
+			profile: &entity.Profile{
+				AI: &entity.AIConfig{
+					Ollama: &entity.OllamaConfig{Model: "llama3.1", KeepAlive: "forever"},
+				},
+				Prompt: &entity.PromptConfig{
+					SystemPrompt:          "test system prompt",
+					CommentPromptTemplate: "test prompt template",
+				},
+			},
+			expectValid: false,
+			expectError: []domain.ValidationError{
+				{
+					Field:   "ai.ollama",
+					Type:    domain.ValidationErrorTypeInvalid,
+					Message: "Ollama設定のkeep_aliveが不正です（例: 5m、1h、-1）: forever",
+				},
+			},
+		},
+		{
+			name: "AI呼び出しのリトライの待ち時間が上限を超えている",
+			config: &infra.Config{
+				DefaultProfile: &infra.Profile{},
+			},
+			profile: &entity.Profile{
+				AI: &entity.AIConfig{
+					Ollama: &entity.OllamaConfig{Model: "llama3.1"},
+					Retry:  &entity.AIRetryConfig{InitialInterval: time.Minute},
+				},
+				Prompt: &entity.PromptConfig{
+					SystemPrompt:          "test system prompt",
+					CommentPromptTemplate: "test prompt template",
+				},
+			},
+			expectValid: false,
+			expectError: []domain.ValidationError{
+				{
+					Field:   "ai.retry",
+					Type:    domain.ValidationErrorTypeInvalid,
+					Message: "AI呼び出しの最初のリトライまでの待ち時間（1m0s）が待ち時間の上限（30s）を超えています",
+				},
+			},
+		},
+		{
+			name: "AIの呼び出し順に使用できるプロバイダがない",
+			config: &infra.Config{
+				DefaultProfile: &infra.Profile{},
+			},
+			profile: &entity.Profile{
+				AI: &entity.AIConfig{
+					Gemini: &entity.GeminiConfig{Type: "gemini-2.5-flash"},
+					Chain:  []string{"gemini", "openai"},
+				},
+				Prompt: &entity.PromptConfig{
+					SystemPrompt:          "test system prompt",
+					CommentPromptTemplate: "test prompt template",
+				},
+			},
+			expectValid: false,
+			expectError: []domain.ValidationError{
+				{
+					Field:   "ai.chain",
+					Type:    domain.ValidationErrorTypeInvalid,
+					Message: "AIの呼び出し順に使用できるプロバイダがありません。いずれかのプロバイダを設定するか、mockを追加してください",
+				},
+			},
+		},
 		{
 			name: "プロンプト設定が未設定",
 			config: &infra.Config{
@@ -642,6 +768,224 @@ func TestConfigValidator_Validate_Errors(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "フィード選択戦略が不正",
+			config: &infra.Config{
+				DefaultProfile: &infra.Profile{},
+			},
+			profile: &entity.Profile{
+				AI: &entity.AIConfig{
+					Gemini: &entity.GeminiConfig{
+						Type:   "gemini-1.5-flash",
+						APIKey: entity.NewSecretString("valid-api-key-12345"),
+					},
+				},
+				Prompt: &entity.PromptConfig{
+					SystemPrompt:          "test system prompt",
+					CommentPromptTemplate: "test prompt template",
+				},
+				FeedSelection: &entity.FeedSelectionConfig{Strategy: "newest"},
+			},
+			expectValid: false,
+			expectError: []domain.ValidationError{
+				{
+					Field:   "feed_selection.strategy",
+					Type:    domain.ValidationErrorTypeInvalid,
+					Message: "フィード選択戦略が不正です: \"newest\"（random, weighted, round_robin, least_recent, allのいずれかを指定してください）",
+				},
+			},
+		},
+		{
+			name: "プロンプトに含める本文の上限が負の値",
+			config: &infra.Config{
+				DefaultProfile: &infra.Profile{},
+			},
+			profile: &entity.Profile{
+				AI: &entity.AIConfig{
+					Gemini: &entity.GeminiConfig{
+						Type:   "gemini-1.5-flash",
+						APIKey: entity.NewSecretString("valid-api-key-12345"),
+					},
+				},
+				Prompt: &entity.PromptConfig{
+					SystemPrompt:          "test system prompt",
+					CommentPromptTemplate: "test prompt template",
+					SelectorContentBudget: entity.ContentBudget{MaxChars: -1},
+				},
+			},
+			expectValid: false,
+			expectError: []domain.ValidationError{
+				{
+					Field:   "prompt.content_budget.selector",
+					Type:    domain.ValidationErrorTypeInvalid,
+					Message: "記事選択プロンプトに含める本文の最大文字数には0以上の値を指定してください",
+				},
+			},
+		},
+		{
+			name: "フィードを除外するまでの連続失敗回数が負の値",
+			config: &infra.Config{
+				DefaultProfile: &infra.Profile{},
+			},
+			profile: &entity.Profile{
+				AI: &entity.AIConfig{
+					Gemini: &entity.GeminiConfig{
+						Type:   "gemini-1.5-flash",
+						APIKey: entity.NewSecretString("valid-api-key-12345"),
+					},
+				},
+				Prompt: &entity.PromptConfig{
+					SystemPrompt:          "test system prompt",
+					CommentPromptTemplate: "test prompt template",
+				},
+				FeedHealth: &entity.FeedHealthConfig{FailureThreshold: -1},
+			},
+			expectValid: false,
+			expectError: []domain.ValidationError{
+				{
+					Field:   "feed_health",
+					Type:    domain.ValidationErrorTypeInvalid,
+					Message: "フィードを除外するまでの連続失敗回数には0以上の値を指定してください",
+				},
+			},
+		},
+		{
+			name: "記事フィルタの正規表現が不正",
+			config: &infra.Config{
+				DefaultProfile: &infra.Profile{},
+			},
+			profile: &entity.Profile{
+				AI: &entity.AIConfig{
+					Gemini: &entity.GeminiConfig{
+						Type:   "gemini-1.5-flash",
+						APIKey: entity.NewSecretString("valid-api-key-12345"),
+					},
+				},
+				Prompt: &entity.PromptConfig{
+					SystemPrompt:          "test system prompt",
+					CommentPromptTemplate: "test prompt template",
+				},
+				Filters: &entity.FilterConfig{ExcludePatterns: []string{"[a-"}},
+			},
+			expectValid: false,
+			expectError: []domain.ValidationError{
+				{
+					Field:   "filters",
+					Type:    domain.ValidationErrorTypeInvalid,
+					Message: "記事フィルタの正規表現が不正です: \"[a-\"",
+				},
+			},
+		},
+		{
+			name: "URLから取り除くクエリパラメータ名が空",
+			config: &infra.Config{
+				DefaultProfile: &infra.Profile{},
+			},
+			profile: &entity.Profile{
+				AI: &entity.AIConfig{
+					Gemini: &entity.GeminiConfig{
+						Type:   "gemini-1.5-flash",
+						APIKey: entity.NewSecretString("valid-api-key-12345"),
+					},
+				},
+				Prompt: &entity.PromptConfig{
+					SystemPrompt:          "test system prompt",
+					CommentPromptTemplate: "test prompt template",
+				},
+				CanonicalURL: &entity.CanonicalURLConfig{StripParams: []string{"*"}},
+			},
+			expectValid: false,
+			expectError: []domain.ValidationError{
+				{
+					Field:   "canonical_url.strip_params",
+					Type:    domain.ValidationErrorTypeInvalid,
+					Message: "URLから取り除くクエリパラメータ名が不正です: \"*\"",
+				},
+			},
+		},
+		{
+			name: "重複記事の残し方が不正",
+			config: &infra.Config{
+				DefaultProfile: &infra.Profile{},
+			},
+			profile: &entity.Profile{
+				AI: &entity.AIConfig{
+					Gemini: &entity.GeminiConfig{
+						Type:   "gemini-1.5-flash",
+						APIKey: entity.NewSecretString("valid-api-key-12345"),
+					},
+				},
+				Prompt: &entity.PromptConfig{
+					SystemPrompt:          "test system prompt",
+					CommentPromptTemplate: "test prompt template",
+				},
+				Dedup: &entity.DedupConfig{Keep: "latest"},
+			},
+			expectValid: false,
+			expectError: []domain.ValidationError{
+				{
+					Field:   "dedup",
+					Type:    domain.ValidationErrorTypeInvalid,
+					Message: "重複記事の残し方が不正です: \"latest\"（richest, earliestのいずれかを指定してください）",
+				},
+			},
+		},
+		{
+			name: "同じ話題とみなす類似度が範囲外",
+			config: &infra.Config{
+				DefaultProfile: &infra.Profile{},
+			},
+			profile: &entity.Profile{
+				AI: &entity.AIConfig{
+					Gemini: &entity.GeminiConfig{
+						Type:   "gemini-1.5-flash",
+						APIKey: entity.NewSecretString("valid-api-key-12345"),
+					},
+				},
+				Prompt: &entity.PromptConfig{
+					SystemPrompt:          "test system prompt",
+					CommentPromptTemplate: "test prompt template",
+				},
+				TopicSuppression: &entity.TopicSuppressionConfig{Similarity: 1.5},
+			},
+			expectValid: false,
+			expectError: []domain.ValidationError{
+				{
+					Field:   "topic_suppression",
+					Type:    domain.ValidationErrorTypeInvalid,
+					Message: "同じ話題とみなす類似度には0から1の値を指定してください",
+				},
+			},
+		},
+		{
+			name: "フィードの重みが負の値",
+			config: &infra.Config{
+				DefaultProfile: &infra.Profile{},
+			},
+			profile: &entity.Profile{
+				AI: &entity.AIConfig{
+					Gemini: &entity.GeminiConfig{
+						Type:   "gemini-1.5-flash",
+						APIKey: entity.NewSecretString("valid-api-key-12345"),
+					},
+				},
+				Prompt: &entity.PromptConfig{
+					SystemPrompt:          "test system prompt",
+					CommentPromptTemplate: "test prompt template",
+				},
+				Feeds: []entity.Feed{
+					{URL: "https://example.com/feed.xml", Weight: -1},
+				},
+			},
+			expectValid: false,
+			expectError: []domain.ValidationError{
+				{
+					Field:   "feeds[0]",
+					Type:    domain.ValidationErrorTypeInvalid,
+					Message: "フィード(https://example.com/feed.xml)の重みには0以上の値を指定してください",
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
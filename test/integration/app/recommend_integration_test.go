@@ -5,6 +5,7 @@ package app_test
 import (
 	"bytes"
 	"context"
+	"math/rand/v2"
 	"net/http"
 	"net/http/httptest"
 	"path/filepath"
@@ -142,6 +143,11 @@ func testCacheFactory(c domain.RecommendCache) app.RecommendCacheFactory {
 	}
 }
 
+// testFeedSelectorFactory はテスト用のFeedSelectorファクトリ（ランダム選択）
+func testFeedSelectorFactory(cache domain.RecommendCache, rng *rand.Rand) (domain.FeedSelector, error) {
+	return &domain.RandomFeedSelector{}, nil
+}
+
 // testRunnerSetup はRecommendRunnerとその依存関係をセットアップするためのヘルパー構造体
 type testRunnerSetup struct {
 	fetchClient  domain.FetchClient
@@ -153,6 +159,14 @@ type testRunnerSetup struct {
 	stdoutBuffer *bytes.Buffer
 }
 
+// newFetchClient はデフォルト設定のFetchClientを作成する
+func newFetchClient(t *testing.T) domain.FetchClient {
+	t.Helper()
+	client, err := fetch.NewFetchClient(nil, cache.NewNopFeedStateStore())
+	require.NoError(t, err)
+	return client
+}
+
 // newTestRunner はtestRunnerSetupから初期化済みのRecommendRunnerを作成する
 func newTestRunner(t *testing.T, setup *testRunnerSetup) *app.RecommendRunner {
 	t.Helper()
@@ -164,8 +178,12 @@ func newTestRunner(t *testing.T, setup *testRunnerSetup) *app.RecommendRunner {
 		&entity.OutputConfig{},
 		&entity.PromptConfig{CommentPromptTemplate: "test-template"},
 		setup.cacheConfig,
+		nil, // fetchConfig
 		testSenderFactory(setup.senders),
 		testCacheFactory(setup.cache),
+		testFeedSelectorFactory,
+		nil,
+		nil,
 	)
 	require.NoError(t, err)
 	return runner
@@ -182,7 +200,7 @@ func TestRecommendRunner_Integration_HappyPath(t *testing.T) {
 	stdoutBuffer := new(bytes.Buffer)
 
 	runner := newTestRunner(t, &testRunnerSetup{
-		fetchClient:  fetch.NewFetchClient(),
+		fetchClient:  newFetchClient(t),
 		recommender:  newMockRecommender("This is a test comment"),
 		senders:      []domain.MessageSender{slackSender},
 		cache:        cache.NewNopCache(),
@@ -192,7 +210,7 @@ func TestRecommendRunner_Integration_HappyPath(t *testing.T) {
 	})
 
 	params := &app.RecommendParams{
-		URLs: []string{feedServer.URL},
+		Feeds: entity.NewFeedsFromURLs([]string{feedServer.URL}),
 	}
 	profile := &entity.Profile{}
 
@@ -213,7 +231,7 @@ func TestRecommendRunner_Integration_WithRealFetcher(t *testing.T) {
 	defer feedServer.Close()
 
 	// 実際のFetchClient（infra層）を使用
-	fetchClient := fetch.NewFetchClient()
+	fetchClient := newFetchClient(t)
 
 	// モックRecommenderを使用
 	recommender := newMockRecommender("Integration test comment")
@@ -236,13 +254,17 @@ func TestRecommendRunner_Integration_WithRealFetcher(t *testing.T) {
 		&entity.OutputConfig{},
 		&entity.PromptConfig{CommentPromptTemplate: "test-template"},
 		nil,
+		nil, // fetchConfig
 		testSenderFactory([]domain.MessageSender{slackSender, misskeySender}),
 		testCacheFactory(nopCache),
+		testFeedSelectorFactory,
+		nil,
+		nil,
 	)
 	require.NoError(t, err)
 
 	params := &app.RecommendParams{
-		URLs: []string{feedServer.URL},
+		Feeds: entity.NewFeedsFromURLs([]string{feedServer.URL}),
 	}
 	profile := &entity.Profile{
 		Prompt: &entity.PromptConfig{
@@ -267,7 +289,7 @@ func TestRecommendRunner_Integration_EmptyFeed(t *testing.T) {
 	defer feedServer.Close()
 
 	// 実際のFetchClient（infra層）を使用
-	fetchClient := fetch.NewFetchClient()
+	fetchClient := newFetchClient(t)
 
 	// モックRecommenderを使用
 	recommender := newMockRecommender("Should not be called")
@@ -289,13 +311,17 @@ func TestRecommendRunner_Integration_EmptyFeed(t *testing.T) {
 		&entity.OutputConfig{},
 		&entity.PromptConfig{CommentPromptTemplate: "test-template"},
 		nil,
+		nil, // fetchConfig
 		testSenderFactory([]domain.MessageSender{slackSender}),
 		testCacheFactory(nopCache),
+		testFeedSelectorFactory,
+		nil,
+		nil,
 	)
 	require.NoError(t, err)
 
 	params := &app.RecommendParams{
-		URLs: []string{feedServer.URL},
+		Feeds: entity.NewFeedsFromURLs([]string{feedServer.URL}),
 	}
 	profile := &entity.Profile{}
 
@@ -315,7 +341,7 @@ func TestRecommendRunner_Integration_FeedFetchError(t *testing.T) {
 	defer feedServer.Close()
 
 	// 実際のFetchClient（infra層）を使用
-	fetchClient := fetch.NewFetchClient()
+	fetchClient := newFetchClient(t)
 
 	// モックRecommenderを使用
 	recommender := newMockRecommender("Should not be called")
@@ -337,13 +363,17 @@ func TestRecommendRunner_Integration_FeedFetchError(t *testing.T) {
 		&entity.OutputConfig{},
 		&entity.PromptConfig{CommentPromptTemplate: "test-template"},
 		nil,
+		nil, // fetchConfig
 		testSenderFactory([]domain.MessageSender{slackSender}),
 		testCacheFactory(nopCache),
+		testFeedSelectorFactory,
+		nil,
+		nil,
 	)
 	require.NoError(t, err)
 
 	params := &app.RecommendParams{
-		URLs: []string{feedServer.URL},
+		Feeds: entity.NewFeedsFromURLs([]string{feedServer.URL}),
 	}
 	profile := &entity.Profile{}
 
@@ -377,7 +407,7 @@ func TestRecommendRunner_Integration_WithFileCache(t *testing.T) {
 	fileCache := cache.NewFileRecommendCache(cacheConfig)
 
 	// 実際のFetchClient（infra層）を使用
-	fetchClient := fetch.NewFetchClient()
+	fetchClient := newFetchClient(t)
 
 	// モックRecommenderを使用
 	recommender := newMockRecommender("Cached test comment")
@@ -396,13 +426,17 @@ func TestRecommendRunner_Integration_WithFileCache(t *testing.T) {
 		&entity.OutputConfig{},
 		&entity.PromptConfig{CommentPromptTemplate: "test-template"},
 		cacheConfig,
+		nil, // fetchConfig
 		testSenderFactory([]domain.MessageSender{slackSender}),
 		testCacheFactory(fileCache),
+		testFeedSelectorFactory,
+		nil,
+		nil,
 	)
 	require.NoError(t, err)
 
 	params := &app.RecommendParams{
-		URLs: []string{feedServer.URL},
+		Feeds: entity.NewFeedsFromURLs([]string{feedServer.URL}),
 	}
 	profile := &entity.Profile{}
 
@@ -427,8 +461,12 @@ func TestRecommendRunner_Integration_WithFileCache(t *testing.T) {
 		&entity.OutputConfig{},
 		&entity.PromptConfig{CommentPromptTemplate: "test-template"},
 		cacheConfig,
+		nil, // fetchConfig
 		testSenderFactory([]domain.MessageSender{slackSender2}),
 		testCacheFactory(fileCache2),
+		testFeedSelectorFactory,
+		nil,
+		nil,
 	)
 	require.NoError(t, err)
 
@@ -445,7 +483,7 @@ func TestRecommendRunner_Integration_ConcurrentMultiSender(t *testing.T) {
 	defer feedServer.Close()
 
 	// 実際のFetchClient（infra層）を使用
-	fetchClient := fetch.NewFetchClient()
+	fetchClient := newFetchClient(t)
 
 	// モックRecommenderを使用
 	recommender := newMockRecommender("Concurrent test comment")
@@ -469,13 +507,17 @@ func TestRecommendRunner_Integration_ConcurrentMultiSender(t *testing.T) {
 		&entity.OutputConfig{},
 		&entity.PromptConfig{CommentPromptTemplate: "test-template"},
 		nil,
+		nil, // fetchConfig
 		testSenderFactory([]domain.MessageSender{sender1, sender2, sender3}),
 		testCacheFactory(nopCache),
+		testFeedSelectorFactory,
+		nil,
+		nil,
 	)
 	require.NoError(t, err)
 
 	params := &app.RecommendParams{
-		URLs: []string{feedServer.URL},
+		Feeds: entity.NewFeedsFromURLs([]string{feedServer.URL}),
 	}
 	profile := &entity.Profile{}
 
@@ -497,7 +539,7 @@ func TestRecommendRunner_Integration_SenderError(t *testing.T) {
 	defer feedServer.Close()
 
 	// 実際のFetchClient（infra層）を使用
-	fetchClient := fetch.NewFetchClient()
+	fetchClient := newFetchClient(t)
 
 	// モックRecommenderを使用
 	recommender := newMockRecommender("Error test comment")
@@ -520,13 +562,17 @@ func TestRecommendRunner_Integration_SenderError(t *testing.T) {
 		&entity.OutputConfig{},
 		&entity.PromptConfig{CommentPromptTemplate: "test-template"},
 		nil,
+		nil, // fetchConfig
 		testSenderFactory([]domain.MessageSender{errorSender}),
 		testCacheFactory(nopCache),
+		testFeedSelectorFactory,
+		nil,
+		nil,
 	)
 	require.NoError(t, err)
 
 	params := &app.RecommendParams{
-		URLs: []string{feedServer.URL},
+		Feeds: entity.NewFeedsFromURLs([]string{feedServer.URL}),
 	}
 	profile := &entity.Profile{}
 
@@ -550,7 +596,7 @@ func TestRecommendRunner_Integration_MultipleFeedsWithRetry(t *testing.T) {
 	defer successServer.Close()
 
 	// 実際のFetchClient（infra層）を使用
-	fetchClient := fetch.NewFetchClient()
+	fetchClient := newFetchClient(t)
 
 	// モックRecommenderを使用
 	recommender := newMockRecommender("Retry test comment")
@@ -572,14 +618,18 @@ func TestRecommendRunner_Integration_MultipleFeedsWithRetry(t *testing.T) {
 		&entity.OutputConfig{},
 		&entity.PromptConfig{CommentPromptTemplate: "test-template"},
 		nil,
+		nil, // fetchConfig
 		testSenderFactory([]domain.MessageSender{slackSender}),
 		testCacheFactory(nopCache),
+		testFeedSelectorFactory,
+		nil,
+		nil,
 	)
 	require.NoError(t, err)
 
 	// 両方のフィードURLを渡す（ランダム選択のためどちらが先に選ばれるかは不確定）
 	params := &app.RecommendParams{
-		URLs: []string{failingServer.URL, successServer.URL},
+		Feeds: entity.NewFeedsFromURLs([]string{failingServer.URL, successServer.URL}),
 	}
 	profile := &entity.Profile{}
 
@@ -609,7 +659,7 @@ func TestRecommendRunner_Integration_AllCachedArticles(t *testing.T) {
 		RetentionDays: 7,
 	}
 
-	fetchClient := fetch.NewFetchClient()
+	fetchClient := newFetchClient(t)
 	recommender := newMockRecommender("Test comment")
 	slackSender := newMockMessageSender("Slack")
 
@@ -624,7 +674,7 @@ func TestRecommendRunner_Integration_AllCachedArticles(t *testing.T) {
 	})
 
 	params := &app.RecommendParams{
-		URLs: []string{feedServer.URL},
+		Feeds: entity.NewFeedsFromURLs([]string{feedServer.URL}),
 	}
 	profile := &entity.Profile{}
 
@@ -679,7 +729,7 @@ func TestRecommendRunner_Integration_NoSenders(t *testing.T) {
 	defer feedServer.Close()
 
 	// 実際のFetchClient（infra層）を使用
-	fetchClient := fetch.NewFetchClient()
+	fetchClient := newFetchClient(t)
 
 	// モックRecommenderを使用
 	recommender := newMockRecommender("No sender test comment")
@@ -699,13 +749,17 @@ func TestRecommendRunner_Integration_NoSenders(t *testing.T) {
 		&entity.OutputConfig{},
 		&entity.PromptConfig{CommentPromptTemplate: "test-template"},
 		nil,
+		nil, // fetchConfig
 		testSenderFactory([]domain.MessageSender{}), // 空のsenders
 		testCacheFactory(nopCache),
+		testFeedSelectorFactory,
+		nil,
+		nil,
 	)
 	require.NoError(t, err)
 
 	params := &app.RecommendParams{
-		URLs: []string{feedServer.URL},
+		Feeds: entity.NewFeedsFromURLs([]string{feedServer.URL}),
 	}
 	profile := &entity.Profile{}
 
@@ -725,7 +779,7 @@ func TestRecommendRunner_Integration_WithFixedMessage(t *testing.T) {
 	defer feedServer.Close()
 
 	// 実際のFetchClient（infra層）を使用
-	fetchClient := fetch.NewFetchClient()
+	fetchClient := newFetchClient(t)
 
 	// モックRecommenderを使用
 	recommender := newMockRecommender("Test comment with fixed message")
@@ -751,13 +805,17 @@ func TestRecommendRunner_Integration_WithFixedMessage(t *testing.T) {
 		&entity.OutputConfig{},
 		&entity.PromptConfig{CommentPromptTemplate: "test-template"},
 		nil,
+		nil, // fetchConfig
 		testSenderFactory([]domain.MessageSender{customSender}),
 		testCacheFactory(nopCache),
+		testFeedSelectorFactory,
+		nil,
+		nil,
 	)
 	require.NoError(t, err)
 
 	params := &app.RecommendParams{
-		URLs: []string{feedServer.URL},
+		Feeds: entity.NewFeedsFromURLs([]string{feedServer.URL}),
 	}
 	profile := &entity.Profile{
 		Prompt: &entity.PromptConfig{